@@ -0,0 +1,124 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const binaryName = "tesla-delivery-tui"
+
+// completionShells lists the shells supported by --completion
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// completionFlag describes a command-line flag for completion scripts
+type completionFlag struct {
+	name       string
+	usage      string
+	takesValue bool
+}
+
+// completionFlags enumerates all flags registered on the flag set
+func completionFlags(fs *flag.FlagSet) []completionFlag {
+	var flags []completionFlag
+	fs.VisitAll(func(f *flag.Flag) {
+		takesValue := true
+		if bf, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && bf.IsBoolFlag() {
+			takesValue = false
+		}
+		flags = append(flags, completionFlag{name: f.Name, usage: f.Usage, takesValue: takesValue})
+	})
+	return flags
+}
+
+// writeCompletion writes the completion script for the given shell
+func writeCompletion(w io.Writer, shell string, fs *flag.FlagSet) error {
+	flags := completionFlags(fs)
+
+	switch shell {
+	case "bash":
+		writeBashCompletion(w, flags)
+	case "zsh":
+		writeZshCompletion(w, flags)
+	case "fish":
+		writeFishCompletion(w, flags)
+	default:
+		return fmt.Errorf("unsupported shell %q (supported: %s)", shell, strings.Join(completionShells, ", "))
+	}
+	return nil
+}
+
+func writeBashCompletion(w io.Writer, flags []completionFlag) {
+	names := make([]string, 0, len(flags))
+	for _, f := range flags {
+		names = append(names, "--"+f.name)
+	}
+
+	fmt.Fprintf(w, "# bash completion for %s\n", binaryName)
+	fmt.Fprintln(w, "_tesla_delivery_tui() {")
+	fmt.Fprintln(w, `    local cur="${COMP_WORDS[COMP_CWORD]}"`)
+	fmt.Fprintln(w, `    local prev="${COMP_WORDS[COMP_CWORD-1]}"`)
+	fmt.Fprintln(w, `    case "$prev" in`)
+	fmt.Fprintf(w, "        --completion)\n            COMPREPLY=( $(compgen -W \"%s\" -- \"$cur\") )\n            return ;;\n", strings.Join(completionShells, " "))
+	fmt.Fprintln(w, "        --config-dir)")
+	fmt.Fprintln(w, `            COMPREPLY=( $(compgen -d -- "$cur") )`)
+	fmt.Fprintln(w, "            return ;;")
+	fmt.Fprintln(w, "        --log-file)")
+	fmt.Fprintln(w, `            COMPREPLY=( $(compgen -f -- "$cur") )`)
+	fmt.Fprintln(w, "            return ;;")
+	fmt.Fprintln(w, "    esac")
+	fmt.Fprintf(w, "    COMPREPLY=( $(compgen -W \"%s\" -- \"$cur\") )\n", strings.Join(names, " "))
+	fmt.Fprintln(w, "}")
+	fmt.Fprintf(w, "complete -F _tesla_delivery_tui %s\n", binaryName)
+}
+
+func writeZshCompletion(w io.Writer, flags []completionFlag) {
+	fmt.Fprintf(w, "#compdef %s\n\n", binaryName)
+	fmt.Fprintln(w, "_arguments \\")
+	for i, f := range flags {
+		desc := zshEscape(f.usage)
+		spec := fmt.Sprintf("'--%s[%s]'", f.name, desc)
+		if f.takesValue {
+			values := ""
+			switch f.name {
+			case "completion":
+				values = "(" + strings.Join(completionShells, " ") + ")"
+			case "log-file":
+				values = "_files"
+			case "config-dir":
+				values = "_files -/"
+			}
+			spec = fmt.Sprintf("'--%s=[%s]:%s:%s'", f.name, desc, f.name, values)
+		}
+		if i < len(flags)-1 {
+			spec += " \\"
+		}
+		fmt.Fprintf(w, "  %s\n", spec)
+	}
+}
+
+func writeFishCompletion(w io.Writer, flags []completionFlag) {
+	fmt.Fprintf(w, "# fish completion for %s\n", binaryName)
+	for _, f := range flags {
+		line := fmt.Sprintf("complete -c %s -l %s -d '%s'", binaryName, f.name, fishEscape(f.usage))
+		if f.takesValue {
+			line += " -r"
+			if f.name == "completion" {
+				line += fmt.Sprintf(" -f -a '%s'", strings.Join(completionShells, " "))
+			}
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+// zshEscape escapes characters with special meaning in _arguments specs
+func zshEscape(s string) string {
+	r := strings.NewReplacer("'", `'\''`, "[", `\[`, "]", `\]`, ":", `\:`)
+	return r.Replace(s)
+}
+
+// fishEscape escapes single quotes for fish
+func fishEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s)
+}
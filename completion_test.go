@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+)
+
+// testFlagSet returns a flag set with the flags main registers
+func testFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(binaryName, flag.ContinueOnError)
+	registerFlags(fs)
+	return fs
+}
+
+func TestWriteCompletion_ContainsAllFlags(t *testing.T) {
+	var flags []string
+	testFlagSet().VisitAll(func(f *flag.Flag) {
+		flags = append(flags, f.Name)
+	})
+	if len(flags) == 0 {
+		t.Fatal("no flags registered")
+	}
+
+	for _, shell := range completionShells {
+		t.Run(shell, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeCompletion(&buf, shell, testFlagSet()); err != nil {
+				t.Fatalf("writeCompletion(%q) error = %v", shell, err)
+			}
+
+			out := buf.String()
+			if !strings.Contains(out, binaryName) {
+				t.Errorf("%s completion does not reference %s", shell, binaryName)
+			}
+			for _, name := range flags {
+				want := "--" + name
+				if shell == "fish" {
+					want = "-l " + name
+				}
+				if !strings.Contains(out, want) {
+					t.Errorf("%s completion missing flag %q", shell, name)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteCompletion_Descriptions(t *testing.T) {
+	for _, shell := range []string{"zsh", "fish"} {
+		var buf bytes.Buffer
+		if err := writeCompletion(&buf, shell, testFlagSet()); err != nil {
+			t.Fatalf("writeCompletion(%q) error = %v", shell, err)
+		}
+		if !strings.Contains(buf.String(), "Run in demo mode with mock data") {
+			t.Errorf("%s completion missing flag description", shell)
+		}
+	}
+}
+
+func TestWriteCompletion_UnsupportedShell(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeCompletion(&buf, "tcsh", testFlagSet()); err == nil {
+		t.Error("writeCompletion() should fail for unsupported shell")
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+)
+
+// cliFlags holds the parsed command-line flags
+type cliFlags struct {
+	demoMode       bool
+	demoScenario   string
+	showVersion    bool
+	versionCheck   bool
+	noVersionCheck bool
+	healthCheck    bool
+	watchMode      bool
+	watchInterval  time.Duration
+	toastDuration  time.Duration
+	region         string
+	fleetAPI       bool
+	rateLimit      int
+	logFile        string
+	debug          bool
+	completion     string
+	noArt          bool
+	minimal        bool
+	noColor        bool
+	minWidth       int
+	minHeight      int
+	sound          bool
+	showArchived   bool
+	noKeyring      bool
+	configDir      string
+}
+
+// registerFlags defines the command-line flags on fs. main registers them on
+// the default flag set; tests use their own set, so completions always cover
+// the real flags.
+func registerFlags(fs *flag.FlagSet) *cliFlags {
+	f := &cliFlags{}
+	fs.BoolVar(&f.demoMode, "demo", false, "Run in demo mode with mock data")
+	fs.StringVar(&f.demoScenario, "demo-scenario", "", "Demo data to show: "+strings.Join(demo.Scenarios, ", ")+" (implies --demo)")
+	fs.BoolVar(&f.showVersion, "version", false, "Show version information")
+	fs.BoolVar(&f.versionCheck, "version-check", false, "Check GitHub for a newer release and exit")
+	fs.BoolVar(&f.noVersionCheck, "no-version-check", false, "Don't check for a newer release at startup")
+	fs.BoolVar(&f.healthCheck, "health-check", false, "Check authentication and API connectivity, then exit (0 ok, 1 auth failed, 2 network error)")
+	fs.BoolVar(&f.watchMode, "watch", false, "Auto-refresh every 5 minutes")
+	fs.DurationVar(&f.watchInterval, "interval", 5*time.Minute, "Auto-refresh interval (e.g., 10m, 1h)")
+	fs.DurationVar(&f.toastDuration, "toast-duration", 3*time.Second, "How long notifications stay visible (e.g., 1s, 5s)")
+	fs.StringVar(&f.region, "region", "", "API region: "+strings.Join(config.Regions, ", ")+" (default: detected at login)")
+	fs.BoolVar(&f.fleetAPI, "fleet-api", false, "Use the Tesla Fleet API instead of the legacy owner API")
+	fs.IntVar(&f.rateLimit, "rate-limit", api.DefaultRequestsPerMinute, "Maximum Tesla API requests per minute (0 disables)")
+	fs.StringVar(&f.logFile, "log-file", "", "Write structured JSON logs to this file")
+	fs.BoolVar(&f.debug, "debug", false, "Log every TUI message to --log-file, or to debug.log in the config directory without it")
+	fs.StringVar(&f.completion, "completion", "", "Print a shell completion script (bash, zsh or fish)")
+	fs.BoolVar(&f.noArt, "no-art", false, "Hide the vehicle silhouette in the Details tab")
+	fs.BoolVar(&f.minimal, "minimal", false, "Plain rendering without ASCII art, animations or decorative borders")
+	fs.BoolVar(&f.noColor, "no-color", false, "Disable colors and use ASCII table borders")
+	fs.IntVar(&f.minWidth, "min-width", 0, "Minimum terminal width before a \"too small\" warning (default from settings, 80)")
+	fs.IntVar(&f.minHeight, "min-height", 0, "Minimum terminal height before a \"too small\" warning (default from settings, 24)")
+	fs.BoolVar(&f.sound, "sound", false, "Ring the terminal bell when a VIN is assigned or an appointment is booked")
+	fs.BoolVar(&f.showArchived, "show-archived", false, "Include archived orders in the orders list")
+	fs.BoolVar(&f.noKeyring, "no-keyring", false, "Store tokens in the encrypted file instead of the OS keyring")
+	fs.StringVar(&f.configDir, "config-dir", "", "Directory for tokens, settings and history (default ~/.config/tesla-delivery-tui)")
+	return f
+}
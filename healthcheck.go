@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+)
+
+// Exit codes for --health-check
+const (
+	healthOK           = 0
+	healthAuthFailed   = 1
+	healthNetworkError = 2
+)
+
+// runHealthCheck authenticates with the saved tokens, refreshing them if
+// needed, and makes one orders request. It prints a one-line status to w and
+// returns the process exit code.
+func runHealthCheck(w io.Writer, cfg *config.Config, client *api.Client) int {
+	tokens, err := cfg.LoadTokens()
+	if err != nil {
+		fmt.Fprintf(w, "ERROR: failed to load tokens: %v\n", err)
+		return healthAuthFailed
+	}
+	if tokens == nil {
+		fmt.Fprintln(w, "ERROR: not authenticated, run tesla-delivery-tui to sign in")
+		return healthAuthFailed
+	}
+	client.SetTokens(tokens)
+
+	if err := client.CheckHealth(); err != nil {
+		switch {
+		case api.IsNetworkError(err):
+			fmt.Fprintf(w, "ERROR: API unreachable: %v\n", err)
+			return healthNetworkError
+		case api.IsAuthError(err):
+			fmt.Fprintf(w, "ERROR: authentication failed: %v\n", err)
+			return healthAuthFailed
+		default:
+			// Server errors say nothing about the credentials
+			fmt.Fprintf(w, "ERROR: %v\n", err)
+			return healthNetworkError
+		}
+	}
+
+	fmt.Fprintln(w, "OK: authenticated, API reachable")
+	return healthOK
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+	"github.com/zalando/go-keyring"
+)
+
+func TestRunHealthCheck(t *testing.T) {
+	keyring.MockInit()
+
+	tests := []struct {
+		name       string
+		status     int  // response status of the mock server
+		refused    bool // close the server before the check
+		wantCode   int
+		wantPrefix string
+	}{
+		{"API reachable", http.StatusOK, false, healthOK, "OK: authenticated, API reachable"},
+		{"unauthorized", http.StatusUnauthorized, false, healthAuthFailed, "ERROR: authentication failed"},
+		{"connection refused", 0, true, healthNetworkError, "ERROR: API unreachable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+			if err != nil {
+				t.Fatalf("Failed to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(tempDir)
+
+			cfg, err := config.NewWithDir(tempDir)
+			if err != nil {
+				t.Fatalf("NewWithDir() error = %v", err)
+			}
+			// No refresh token, so a 401 is not retried against Tesla's auth server
+			if err := cfg.SaveTokens(&model.TeslaTokens{AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+				t.Fatalf("SaveTokens() error = %v", err)
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"response": []}`))
+			}))
+			if tt.refused {
+				server.Close()
+			} else {
+				defer server.Close()
+			}
+
+			client := api.NewClient(cfg)
+			client.FleetAPIBaseURL = server.URL
+
+			var out bytes.Buffer
+			if code := runHealthCheck(&out, cfg, client); code != tt.wantCode {
+				t.Errorf("runHealthCheck() = %d, want %d (output %q)", code, tt.wantCode, out.String())
+			}
+			if !strings.HasPrefix(out.String(), tt.wantPrefix) {
+				t.Errorf("output = %q, want prefix %q", out.String(), tt.wantPrefix)
+			}
+			if strings.Count(out.String(), "\n") != 1 {
+				t.Errorf("output = %q, want a single line", out.String())
+			}
+		})
+	}
+}
+
+func TestRunHealthCheck_NoTokens(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	keyring.MockInit()
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if code := runHealthCheck(&out, cfg, api.NewClient(cfg)); code != healthAuthFailed {
+		t.Errorf("runHealthCheck() = %d, want %d", code, healthAuthFailed)
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
@@ -38,12 +39,14 @@ type AuthSession struct {
 // Auth handles Tesla OAuth2 authentication
 type Auth struct {
 	httpClient *http.Client
+	logger     *slog.Logger
 }
 
 // NewAuth creates a new Auth instance
 func NewAuth() *Auth {
 	return &Auth{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: newHTTPClient(),
+		logger:     slog.New(slog.DiscardHandler),
 	}
 }
 
@@ -127,6 +130,18 @@ func (a *Auth) exchangeCodeForTokens(code, codeVerifier string) (*model.TeslaTok
 
 // RefreshTokens uses the refresh token to get new tokens
 func (a *Auth) RefreshTokens(refreshToken string) (*model.TeslaTokens, error) {
+	start := time.Now()
+	tokens, err := a.refreshTokens(refreshToken)
+	if err != nil {
+		a.logger.Warn("token refresh", "success", false, "duration", time.Since(start), "error", err.Error())
+		return nil, err
+	}
+	a.logger.Info("token refresh", "success", true, "duration", time.Since(start))
+	return tokens, nil
+}
+
+// refreshTokens performs the refresh token grant
+func (a *Auth) refreshTokens(refreshToken string) (*model.TeslaTokens, error) {
 	data := url.Values{
 		"grant_type":    {"refresh_token"},
 		"client_id":     {clientID},
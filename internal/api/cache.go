@@ -0,0 +1,62 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// cachedResponse holds a response body and the ETag it was served with
+type cachedResponse struct {
+	body []byte
+	etag string
+}
+
+// lookupCache returns the cached response for a URL, if any
+func (c *Client) lookupCache(url string) (cachedResponse, bool) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+
+	cached, ok := c.cache[url]
+	return cached, ok
+}
+
+// cacheResponse stores successful GET responses that carry an ETag and turns
+// a 304 Not Modified into a 200 with the cached body
+func (c *Client) cacheResponse(method, url string, resp *http.Response) (*http.Response, error) {
+	if method != http.MethodGet {
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		cached, ok := c.lookupCache(url)
+		if !ok {
+			return resp, nil
+		}
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Status = fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK))
+		resp.Body = io.NopCloser(bytes.NewReader(cached.body))
+		resp.ContentLength = int64(len(cached.body))
+		return resp, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	c.cacheMu.Lock()
+	c.cache[url] = cachedResponse{body: body, etag: etag}
+	c.cacheMu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
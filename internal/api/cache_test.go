@@ -0,0 +1,92 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestClient_ETagCaching(t *testing.T) {
+	const etag = `"v1"`
+	const body = `{"response": [{"referenceNumber": "RN123456789"}]}`
+
+	var gotIfNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIfNoneMatch = append(gotIfNoneMatch, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Get() #%d error = %v", i+1, err)
+		}
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("ReadAll() #%d error = %v", i+1, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Get() #%d StatusCode = %d, want %d", i+1, resp.StatusCode, http.StatusOK)
+		}
+		if string(data) != body {
+			t.Errorf("Get() #%d body = %q, want %q", i+1, data, body)
+		}
+	}
+
+	if len(gotIfNoneMatch) != 2 {
+		t.Fatalf("server received %d requests, want 2", len(gotIfNoneMatch))
+	}
+	if gotIfNoneMatch[0] != "" {
+		t.Errorf("first request If-None-Match = %q, want empty", gotIfNoneMatch[0])
+	}
+	if gotIfNoneMatch[1] != etag {
+		t.Errorf("second request If-None-Match = %q, want %q", gotIfNoneMatch[1], etag)
+	}
+}
+
+func TestClient_NoETagNotCached(t *testing.T) {
+	var gotIfNoneMatch string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIfNoneMatch = r.Header.Get("If-None-Match")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if gotIfNoneMatch != "" {
+		t.Errorf("If-None-Match = %q, want empty without ETag", gotIfNoneMatch)
+	}
+}
@@ -4,30 +4,104 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
 )
 
+const (
+	// maxRetryAfter caps how long a Retry-After header can make us wait
+	maxRetryAfter = 60 * time.Second
+	// rateLimitBackoff is used when a 429 response has no Retry-After header
+	rateLimitBackoff = 2 * time.Second
+)
+
+// sleep is swapped out in tests to avoid real delays
+var sleep = time.Sleep
+
+// requestTimeout bounds each Tesla API and auth request
+const requestTimeout = 30 * time.Second
+
+// proxyFromEnvironment picks the proxy for a request from HTTPS_PROXY,
+// HTTP_PROXY and NO_PROXY; swapped out in tests, since the standard library
+// reads the environment only once
+var proxyFromEnvironment = http.ProxyFromEnvironment
+
+// newHTTPClient returns the HTTP client for Tesla requests, which goes
+// through the proxy configured in the environment, if any
+func newHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFromEnvironment(req)
+	}
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
 // Client is the Tesla API client
 type Client struct {
+	// FleetAPIBaseURL sends owner API requests to this base URL, overriding
+	// the regional endpoints; empty uses them
+	FleetAPIBaseURL string
+
 	httpClient *http.Client
 	config     *config.Config
 	auth       *Auth
 	tokens     *model.TeslaTokens
+	logger     *slog.Logger
+	limiter    *RateLimiter // nil disables rate limiting
+	forcing    atomic.Int32 // > 0 while a forced fetch skips the limiter
+	region     string       // API region, see config.Regions
+	fleetAPI   bool         // use the Fleet API instead of the owner API
 	mu sync.Mutex // protects token refresh
+
+	cacheMu sync.Mutex
+	cache   map[string]cachedResponse // ETag cache keyed by URL
 }
 
 // NewClient creates a new Tesla API client
 func NewClient(cfg *config.Config) *Client {
 	return &Client{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: newHTTPClient(),
 		config:     cfg,
 		auth:       NewAuth(),
+		logger:     slog.New(slog.DiscardHandler),
+		limiter:    NewRateLimiter(DefaultRequestsPerMinute),
+		cache:      make(map[string]cachedResponse),
+	}
+}
+
+// SetRegion selects the regional API endpoints; unknown regions fall back
+// to North America
+func (c *Client) SetRegion(region string) {
+	c.region = region
+}
+
+// SetFleetAPI switches between the Fleet API and the legacy owner API
+func (c *Client) SetFleetAPI(enabled bool) {
+	c.fleetAPI = enabled
+}
+
+// SetRateLimit sets how many requests per minute the client sends;
+// zero or less disables rate limiting
+func (c *Client) SetRateLimit(perMinute int) {
+	if perMinute <= 0 {
+		c.limiter = nil
+		return
 	}
+	c.limiter = NewRateLimiter(perMinute)
+}
+
+// SetLogger sets the structured logger used for request and token refresh events
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+	c.auth.logger = logger
 }
 
 // SetTokens sets the current tokens
@@ -48,7 +122,7 @@ func (c *Client) Auth() *Auth {
 // EnsureValidTokens ensures tokens are valid, refreshing if needed
 func (c *Client) EnsureValidTokens() error {
 	if c.tokens == nil {
-		return fmt.Errorf("not authenticated")
+		return &AuthError{fmt.Errorf("not authenticated")}
 	}
 
 	if !c.tokens.IsExpired() {
@@ -58,7 +132,7 @@ func (c *Client) EnsureValidTokens() error {
 	// Attempt to refresh tokens
 	newTokens, err := c.auth.RefreshTokens(c.tokens.RefreshToken)
 	if err != nil {
-		return fmt.Errorf("failed to refresh tokens: %w", err)
+		return &AuthError{fmt.Errorf("failed to refresh tokens: %w", err)}
 	}
 
 	c.tokens = newTokens
@@ -77,21 +151,18 @@ func (c *Client) doRequest(method, url string, body io.Reader) (*http.Response,
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, url, body)
+	req, err := c.newRequest(method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.tokens.AccessToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
-	// Check for auth errors
-	if resp.StatusCode == http.StatusUnauthorized {
+	// Check for auth errors; without a refresh token the 401 is returned as is
+	if resp.StatusCode == http.StatusUnauthorized && c.tokens.RefreshToken != "" {
 		resp.Body.Close()
 
 		// Lock to prevent concurrent refresh attempts
@@ -99,7 +170,7 @@ func (c *Client) doRequest(method, url string, body io.Reader) (*http.Response,
 		newTokens, err := c.auth.RefreshTokens(c.tokens.RefreshToken)
 		if err != nil {
 			c.mu.Unlock()
-			return nil, fmt.Errorf("token expired and refresh failed: %w", err)
+			return nil, &AuthError{fmt.Errorf("token expired and refresh failed: %w", err)}
 		}
 
 		c.tokens = newTokens
@@ -110,19 +181,113 @@ func (c *Client) doRequest(method, url string, body io.Reader) (*http.Response,
 		c.mu.Unlock()
 
 		// Retry the request with new token
-		req, err = http.NewRequest(method, url, body)
+		req, err = c.newRequest(method, url, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create retry request: %w", err)
+		}
+
+		resp, err = c.send(req)
+		if err != nil {
+			return nil, fmt.Errorf("retry request failed: %w", err)
+		}
+	}
+
+	// Back off and retry once when rate limited
+	if resp.StatusCode == http.StatusTooManyRequests {
+		resp.Body.Close()
+
+		wait := retryAfter(resp.Header.Get("Retry-After"), time.Now())
+		c.logger.Warn("rate limited", "url", url, "retry_after", wait)
+		sleep(wait)
+
+		req, err = c.newRequest(method, url, body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create retry request: %w", err)
 		}
-		req.Header.Set("Authorization", "Bearer "+c.tokens.AccessToken)
-		req.Header.Set("Content-Type", "application/json")
 
-		resp, err = c.httpClient.Do(req)
+		resp, err = c.send(req)
 		if err != nil {
 			return nil, fmt.Errorf("retry request failed: %w", err)
 		}
 	}
 
+	return c.cacheResponse(method, url, resp)
+}
+
+// newRequest creates an authenticated request, adding If-None-Match when a
+// cached response exists for the URL
+func (c *Client) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.tokens.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	if method == http.MethodGet {
+		if cached, ok := c.lookupCache(url); ok {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+	}
+
+	return req, nil
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP-date.
+// Missing or invalid values fall back to rateLimitBackoff; the result is capped
+// at maxRetryAfter.
+func retryAfter(header string, now time.Time) time.Duration {
+	wait := rateLimitBackoff
+	if header != "" {
+		if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		} else if t, err := http.ParseTime(header); err == nil {
+			wait = t.Sub(now)
+			if wait < 0 {
+				wait = 0
+			}
+		}
+	}
+
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait
+}
+
+// send waits for the rate limiter (unless a forced fetch is running), then executes a request and logs its
+// method, URL, status and duration
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.limiter != nil {
+		if c.forcing.Load() > 0 {
+			// Still use up a token, so later requests stay within the limit
+			c.limiter.Allow()
+		} else if err := c.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		c.logger.Error("http request",
+			"method", req.Method,
+			"url", req.URL.String(),
+			"duration", duration,
+			"error", err.Error(),
+		)
+		return nil, err
+	}
+
+	c.logger.Info("http request",
+		"method", req.Method,
+		"url", req.URL.String(),
+		"status", resp.StatusCode,
+		"duration", duration,
+	)
 	return resp, nil
 }
 
@@ -137,7 +302,7 @@ func decodeResponse(resp *http.Response, target interface{}) error {
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return newAPIError(resp.StatusCode, body)
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
@@ -0,0 +1,496 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestClient_LogsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewClient(nil)
+	client.SetLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	resp, err := client.Get(server.URL + "/orders")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("log output is not valid JSON: %v\n%s", err, buf.String())
+	}
+
+	for _, key := range []string{"time", "level", "msg", "method", "url", "status", "duration"} {
+		if _, ok := entry[key]; !ok {
+			t.Errorf("log entry missing key %q: %s", key, buf.String())
+		}
+	}
+
+	if entry["method"] != "GET" {
+		t.Errorf("method = %v, want GET", entry["method"])
+	}
+	if entry["url"] != server.URL+"/orders" {
+		t.Errorf("url = %v, want %s", entry["url"], server.URL+"/orders")
+	}
+	if status, _ := entry["status"].(float64); int(status) != http.StatusOK {
+		t.Errorf("status = %v, want %d", entry["status"], http.StatusOK)
+	}
+}
+
+func TestClient_UsesProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxy receives the absolute URL of the target
+		proxied = r.URL.String()
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := proxyFromEnvironment
+	// As if HTTPS_PROXY and HTTP_PROXY pointed at the test server
+	proxyFromEnvironment = func(*http.Request) (*url.URL, error) { return proxyURL, nil }
+	defer func() { proxyFromEnvironment = orig }()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour)})
+
+	resp, err := client.Get("http://owner-api.invalid/api/1/users/orders")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if proxied != "http://owner-api.invalid/api/1/users/orders" {
+		t.Errorf("proxy received %q, want the owner API URL", proxied)
+	}
+
+	// Auth requests use the proxy as well
+	proxied = ""
+	resp, err = NewAuth().httpClient.Get("http://auth.invalid/oauth2/v3/token")
+	if err != nil {
+		t.Fatalf("auth Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if proxied != "http://auth.invalid/oauth2/v3/token" {
+		t.Errorf("proxy received %q, want the auth URL", proxied)
+	}
+}
+
+func TestClient_NoLoggerWritesNothing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	// The default logger discards output; this must not panic
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestClient_RetriesAfter429(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer server.Close()
+
+	var slept time.Duration
+	origSleep := sleep
+	sleep = func(d time.Duration) { slept = d }
+	defer func() { sleep = origSleep }()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+	if slept != time.Second {
+		t.Errorf("slept %v, want 1s from Retry-After", slept)
+	}
+}
+
+func TestClient_RateLimitedError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("slow down"))
+	}))
+	defer server.Close()
+
+	var slept time.Duration
+	origSleep := sleep
+	sleep = func(d time.Duration) { slept = d }
+	defer func() { sleep = origSleep }()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	var target map[string]interface{}
+	err = decodeResponse(resp, &target)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("decodeResponse() error = %v, want *APIError", err)
+	}
+	if !apiErr.RateLimited {
+		t.Error("RateLimited = false, want true for 429")
+	}
+	if slept != rateLimitBackoff {
+		t.Errorf("slept %v, want standard backoff %v without Retry-After", slept, rateLimitBackoff)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{"missing", "", rateLimitBackoff},
+		{"seconds", "5", 5 * time.Second},
+		{"capped", "600", maxRetryAfter},
+		{"http date", now.Add(10 * time.Second).Format(http.TimeFormat), 10 * time.Second},
+		{"date in past", now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"invalid", "soon", rateLimitBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfter(tt.header, now); got != tt.want {
+				t.Errorf("retryAfter(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+// rewriteTransport sends every request to the test server, keeping the path and query
+type rewriteTransport struct {
+	target string
+}
+
+func (rt rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, _ := url.Parse(rt.target)
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_GetAllOrderDataProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/users/orders" {
+			w.Write([]byte(`{"response": [{"referenceNumber": "RN1"}, {"referenceNumber": "RN2"}, {"referenceNumber": "RN3"}]}`))
+			return
+		}
+		w.Write([]byte(`{"tasks": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.httpClient = &http.Client{Transport: rewriteTransport{target: server.URL}}
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	var got [][2]int
+	orders, err := client.GetAllOrderData(func(current, total int) {
+		got = append(got, [2]int{current, total})
+	})
+	if err != nil {
+		t.Fatalf("GetAllOrderData() error = %v", err)
+	}
+	if len(orders) != 3 {
+		t.Fatalf("GetAllOrderData() returned %d orders, want 3", len(orders))
+	}
+
+	want := [][2]int{{1, 3}, {2, 3}, {3, 3}}
+	if len(got) != len(want) {
+		t.Fatalf("progress calls = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("progress call %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestClient_GetOrderDataForFetchesIncludedDetailsOnly(t *testing.T) {
+	var detailRequests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/users/orders" {
+			w.Write([]byte(`{"response": [{"referenceNumber": "RN1"}, {"referenceNumber": "RN2"}, {"referenceNumber": "RN3"}]}`))
+			return
+		}
+		detailRequests = append(detailRequests, r.URL.RequestURI())
+		w.Write([]byte(`{"tasks": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.httpClient = &http.Client{Transport: rewriteTransport{target: server.URL}}
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	var progress [][2]int
+	orders, err := client.GetOrderDataFor(func(ref string) bool { return ref == "RN2" }, func(current, total int) {
+		progress = append(progress, [2]int{current, total})
+	})
+	if err != nil {
+		t.Fatalf("GetOrderDataFor() error = %v", err)
+	}
+	if len(orders) != 3 {
+		t.Fatalf("GetOrderDataFor() returned %d orders, want 3", len(orders))
+	}
+	if len(detailRequests) != 1 || !strings.Contains(detailRequests[0], "RN2") {
+		t.Errorf("detail requests = %v, want only RN2", detailRequests)
+	}
+	if len(progress) != 1 || progress[0] != [2]int{1, 1} {
+		t.Errorf("progress calls = %v, want [[1 1]]", progress)
+	}
+}
+
+func TestClient_ForceGetAllOrderDataSkipsRateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/users/orders" {
+			w.Write([]byte(`{"response": [{"referenceNumber": "RN1"}, {"referenceNumber": "RN2"}]}`))
+			return
+		}
+		w.Write([]byte(`{"tasks": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.httpClient = &http.Client{Transport: rewriteTransport{target: server.URL}}
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	// One request per minute: the second request would wait ~60s
+	client.SetRateLimit(1)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.ForceGetAllOrderData(nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("ForceGetAllOrderData() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ForceGetAllOrderData() waited for the rate limiter")
+	}
+
+	if client.limiter.Allow() {
+		t.Error("forced requests should still use up rate limiter tokens")
+	}
+	if client.forcing.Load() != 0 {
+		t.Errorf("forcing = %d after the forced fetch, want 0", client.forcing.Load())
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plain error", errors.New("boom"), false},
+		{"api error", newAPIError(http.StatusInternalServerError, nil), false},
+		{"dial error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, true},
+		{"wrapped url error", fmt.Errorf("request failed: %w", &url.Error{Op: "Get", URL: "https://example.com", Err: &net.DNSError{Err: "no such host", Name: "example.com"}}), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAuthError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plain error", errors.New("boom"), false},
+		{"server error", newAPIError(http.StatusInternalServerError, nil), false},
+		{"unauthorized", newAPIError(http.StatusUnauthorized, nil), true},
+		{"forbidden", fmt.Errorf("failed to fetch orders: %w", newAPIError(http.StatusForbidden, nil)), true},
+		{"refresh failed", &AuthError{errors.New("token refresh failed")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAuthError(tt.err); got != tt.want {
+				t.Errorf("IsAuthError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_UnreachableServerIsNetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	addr := server.URL
+	server.Close()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour)})
+
+	_, err := client.Get(addr)
+	if !IsNetworkError(err) {
+		t.Errorf("IsNetworkError(%v) = false, want true", err)
+	}
+}
+
+// hostRecordingTransport records the requested host before sending the
+// request to target
+type hostRecordingTransport struct {
+	target string
+	hosts  *[]string
+}
+
+func (rt hostRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	*rt.hosts = append(*rt.hosts, req.URL.Host)
+	return rewriteTransport{target: rt.target}.RoundTrip(req)
+}
+
+func TestClient_APIBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/users/orders" {
+			t.Errorf("path = %s, want /api/1/users/orders", r.URL.Path)
+		}
+		w.Write([]byte(`{"response": [{"referenceNumber": "RN1"}]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		fleetURL string
+		wantHost string
+	}{
+		{"owner API by default", "", "owner-api.teslamotors.com"},
+		{"Fleet API", FleetAPIBaseURL, "fleet-api.prd.na.vn.cloud.tesla.com"},
+		{"trailing slash", FleetAPIBaseURL + "/", "fleet-api.prd.na.vn.cloud.tesla.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hosts []string
+			client := NewClient(nil)
+			client.FleetAPIBaseURL = tt.fleetURL
+			client.httpClient = &http.Client{Transport: hostRecordingTransport{target: server.URL, hosts: &hosts}}
+			client.SetTokens(&model.TeslaTokens{
+				AccessToken: "test-token",
+				ExpiresAt:   time.Now().Add(time.Hour),
+			})
+
+			orders, err := client.GetOrders()
+			if err != nil {
+				t.Fatalf("GetOrders() error = %v", err)
+			}
+			if len(orders) != 1 {
+				t.Errorf("GetOrders() returned %d orders, want 1", len(orders))
+			}
+			if len(hosts) != 1 || hosts[0] != tt.wantHost {
+				t.Errorf("requested hosts = %v, want [%s]", hosts, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestClient_FleetAPIServer(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.FleetAPIBaseURL = server.URL
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "fleet-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	if _, err := client.GetOrders(); err != nil {
+		t.Fatalf("GetOrders() error = %v", err)
+	}
+	if gotAuth != "Bearer fleet-token" {
+		t.Errorf("Authorization = %q, want %q", gotAuth, "Bearer fleet-token")
+	}
+}
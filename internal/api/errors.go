@@ -0,0 +1,62 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// APIError is returned when the Tesla API responds with a non-2xx status
+type APIError struct {
+	StatusCode  int
+	Body        string
+	RateLimited bool // true when the API responded with 429 Too Many Requests
+}
+
+// newAPIError creates an APIError for the given status code and response body
+func newAPIError(statusCode int, body []byte) *APIError {
+	return &APIError{
+		StatusCode:  statusCode,
+		Body:        string(body),
+		RateLimited: statusCode == http.StatusTooManyRequests,
+	}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Body)
+}
+
+// AuthError is returned when the client has no usable credentials: there
+// are no tokens, or refreshing them failed
+type AuthError struct {
+	Err error
+}
+
+func (e *AuthError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// IsAuthError reports whether err was caused by missing or rejected
+// credentials, either an AuthError or a 401/403 API response
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return true
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsNetworkError reports whether err was caused by a network failure, such as
+// a DNS lookup error, a refused connection or a timeout, rather than an API
+// response
+func IsNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,29 @@
+package api
+
+import "github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+
+// ProgressFunc is called after each order's details are fetched, with the
+// 1-based index of the order and the total number of orders
+type ProgressFunc func(current, total int)
+
+// ApiClient is the subset of the Tesla API client used by the TUI
+type ApiClient interface {
+	// GetAllOrderData fetches all orders with their details, reporting
+	// progress to the optional progress callback
+	GetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error)
+	// ForceGetAllOrderData is GetAllOrderData without waiting for the rate
+	// limiter, for refreshes the user explicitly forces
+	ForceGetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error)
+	// GetOrderDataFor fetches all orders, but details only for the orders
+	// include returns true for
+	GetOrderDataFor(include func(referenceNumber string) bool, progress ProgressFunc) ([]model.CombinedOrder, error)
+	// SetTokens sets the tokens used for authenticated requests
+	SetTokens(tokens *model.TeslaTokens)
+	// SetRegion selects the regional API endpoints
+	SetRegion(region string)
+	// Auth returns the OAuth2 handler used for login and token refresh
+	Auth() *Auth
+}
+
+// Ensure Client implements ApiClient
+var _ ApiClient = (*Client)(nil)
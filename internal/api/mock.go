@@ -0,0 +1,147 @@
+package api
+
+import (
+	"sync"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// MockClient is an ApiClient that returns canned responses and records calls.
+// It never performs network requests, which makes it suitable for unit tests.
+type MockClient struct {
+	// Orders is returned by GetAllOrderData
+	Orders []model.CombinedOrder
+	// Err is returned by GetAllOrderData when set
+	Err error
+
+	mu     sync.Mutex
+	tokens *model.TeslaTokens
+	region string
+	calls  []string
+	auth   *Auth
+}
+
+// Ensure MockClient implements ApiClient
+var _ ApiClient = (*MockClient)(nil)
+
+// NewMockClient creates a MockClient returning the given orders
+func NewMockClient(orders []model.CombinedOrder) *MockClient {
+	return &MockClient{
+		Orders: orders,
+		auth:   NewAuth(),
+	}
+}
+
+// GetAllOrderData returns the canned orders or error, reporting progress
+// once per order like the real client
+func (m *MockClient) GetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error) {
+	m.record("GetAllOrderData")
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if progress != nil {
+		for i := range m.Orders {
+			progress(i+1, len(m.Orders))
+		}
+	}
+	return m.Orders, nil
+}
+
+// ForceGetAllOrderData behaves like GetAllOrderData but is recorded
+// separately, so tests can tell forced refreshes apart
+func (m *MockClient) ForceGetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error) {
+	m.record("ForceGetAllOrderData")
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if progress != nil {
+		for i := range m.Orders {
+			progress(i+1, len(m.Orders))
+		}
+	}
+	return m.Orders, nil
+}
+
+// GetOrderDataFor returns the canned orders or error, with empty details
+// for the orders include returns false for
+func (m *MockClient) GetOrderDataFor(include func(referenceNumber string) bool, progress ProgressFunc) ([]model.CombinedOrder, error) {
+	m.record("GetOrderDataFor")
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	orders := make([]model.CombinedOrder, 0, len(m.Orders))
+	for _, order := range m.Orders {
+		if include != nil && !include(order.Order.ReferenceNumber) {
+			order.Details = model.OrderDetails{}
+		}
+		orders = append(orders, order)
+	}
+	if progress != nil {
+		for i := range orders {
+			progress(i+1, len(orders))
+		}
+	}
+	return orders, nil
+}
+
+// SetTokens records the tokens
+func (m *MockClient) SetTokens(tokens *model.TeslaTokens) {
+	m.record("SetTokens")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tokens = tokens
+}
+
+// Tokens returns the tokens last passed to SetTokens
+func (m *MockClient) Tokens() *model.TeslaTokens {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tokens
+}
+
+// SetRegion records the region
+func (m *MockClient) SetRegion(region string) {
+	m.record("SetRegion")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.region = region
+}
+
+// Region returns the region last passed to SetRegion
+func (m *MockClient) Region() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.region
+}
+
+// Auth returns a real Auth handler, so tests should avoid login and refresh flows
+func (m *MockClient) Auth() *Auth {
+	m.record("Auth")
+	return m.auth
+}
+
+// Calls returns the names of the methods called, in order
+func (m *MockClient) Calls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.calls...)
+}
+
+// CallCount returns how many times the named method was called
+func (m *MockClient) CallCount(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, c := range m.calls {
+		if c == method {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MockClient) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, method)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerMinute is the request rate Tesla's API tolerates in
+// practice; it publishes no official limit
+const DefaultRequestsPerMinute = 10
+
+// RateLimiter is a token bucket that allows a fixed number of requests per
+// minute, with bursts of up to that many requests
+type RateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	interval time.Duration // time to refill a single token
+	last     time.Time
+	now      func() time.Time
+}
+
+// NewRateLimiter creates a full rate limiter allowing perMinute requests per
+// minute. Values below one are treated as one.
+func NewRateLimiter(perMinute int) *RateLimiter {
+	if perMinute < 1 {
+		perMinute = 1
+	}
+	r := &RateLimiter{
+		capacity: float64(perMinute),
+		tokens:   float64(perMinute),
+		interval: time.Minute / time.Duration(perMinute),
+		now:      time.Now,
+	}
+	r.last = r.now()
+	return r
+}
+
+// refill adds the tokens earned since the last call. Callers must hold mu.
+func (r *RateLimiter) refill() {
+	now := r.now()
+	elapsed := now.Sub(r.last)
+	if elapsed <= 0 {
+		return
+	}
+	r.tokens += float64(elapsed) / float64(r.interval)
+	if r.tokens > r.capacity {
+		r.tokens = r.capacity
+	}
+	r.last = now
+}
+
+// reserve takes a token if one is available, otherwise it returns how long
+// until the next token is due
+func (r *RateLimiter) reserve() (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill()
+	if r.tokens >= 1 {
+		r.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - r.tokens) * float64(r.interval))
+}
+
+// Allow takes a token if one is available, without waiting
+func (r *RateLimiter) Allow() bool {
+	ok, _ := r.reserve()
+	return ok
+}
+
+// Wait blocks until a token is available or ctx is done
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		ok, delay := r.reserve()
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	clock := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(10)
+	limiter.now = func() time.Time { return clock }
+	limiter.last = clock
+
+	for i := 0; i < 10; i++ {
+		if !limiter.Allow() {
+			t.Fatalf("Allow() = false for request %d, want burst of 10", i+1)
+		}
+	}
+	if limiter.Allow() {
+		t.Error("Allow() = true for request 11, want false")
+	}
+
+	// One token is refilled every 6 seconds at 10 req/min
+	clock = clock.Add(6 * time.Second)
+	if !limiter.Allow() {
+		t.Error("Allow() = false after refill, want true")
+	}
+	if limiter.Allow() {
+		t.Error("Allow() = true with the bucket empty again, want false")
+	}
+}
+
+func TestRateLimiter_WaitDelaysExtraRequest(t *testing.T) {
+	limiter := NewRateLimiter(600) // one token every 100ms
+	for limiter.Allow() {
+	}
+
+	start := time.Now()
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Wait() returned after %v, want it to wait for a token", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(1)
+	limiter.Allow()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Wait() returned after %v, want it to stop on cancellation", elapsed)
+	}
+}
+
+func TestClient_RateLimitsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetTokens(&model.TeslaTokens{
+		AccessToken: "test-token",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+	client.SetRateLimit(600) // one token every 100ms
+	client.limiter.tokens = 2
+
+	get := func() time.Duration {
+		start := time.Now()
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		resp.Body.Close()
+		return time.Since(start)
+	}
+
+	get()
+	get()
+	if elapsed := get(); elapsed < 50*time.Millisecond {
+		t.Errorf("third request took %v, want it delayed by the rate limiter", elapsed)
+	}
+}
+
+func TestClient_SetRateLimitDisables(t *testing.T) {
+	client := NewClient(nil)
+	if client.limiter == nil {
+		t.Fatal("NewClient() should rate limit by default")
+	}
+	client.SetRateLimit(0)
+	if client.limiter != nil {
+		t.Error("SetRateLimit(0) should disable rate limiting")
+	}
+}
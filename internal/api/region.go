@@ -0,0 +1,63 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+)
+
+// Regional base URLs. The owner API is shared by North America and Europe;
+// China has its own. The Fleet API has a separate cluster per region.
+var (
+	ownerAPIBaseURLs = map[string]string{
+		config.RegionNA: OwnerAPIBaseURL,
+		config.RegionEU: OwnerAPIBaseURL,
+		config.RegionCN: "https://owner-api.vn.cloud.tesla.cn",
+	}
+	fleetAPIBaseURLs = map[string]string{
+		config.RegionNA: FleetAPIBaseURL,
+		config.RegionEU: "https://fleet-api.prd.eu.vn.cloud.tesla.com",
+		config.RegionCN: "https://fleet-api.prd.cn.vn.cloud.tesla.cn",
+	}
+)
+
+// tokenClaims holds the access token claims used to detect the region
+type tokenClaims struct {
+	Issuer string `json:"iss"`
+	OUCode string `json:"ou_code"` // account region, e.g. "NA" or "EU"
+}
+
+// DetectRegion derives the API region from an access token's claims. The
+// token signature is not verified; the claims only pick an endpoint. It
+// returns "" when the token is not a JWT or names no known region.
+func DetectRegion(accessToken string) string {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+
+	if region := strings.ToLower(claims.OUCode); config.ValidRegion(region) {
+		return region
+	}
+
+	// Without ou_code only China can be told apart, by its issuer
+	issuer, err := url.Parse(claims.Issuer)
+	if err != nil || issuer.Host == "" {
+		return ""
+	}
+	if strings.HasSuffix(issuer.Host, ".cn") {
+		return config.RegionCN
+	}
+	return config.RegionNA
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// testJWT builds an unsigned JWT with the given claims JSON
+func testJWT(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func TestDetectRegion(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"ou_code EU", testJWT(`{"iss":"https://auth.tesla.com/oauth2/v3/nts","ou_code":"EU"}`), config.RegionEU},
+		{"ou_code NA", testJWT(`{"iss":"https://auth.tesla.com/oauth2/v3/nts","ou_code":"NA"}`), config.RegionNA},
+		{"China issuer", testJWT(`{"iss":"https://auth.tesla.cn/oauth2/v3/nts"}`), config.RegionCN},
+		{"global issuer", testJWT(`{"iss":"https://auth.tesla.com/oauth2/v3/nts"}`), config.RegionNA},
+		{"unknown ou_code falls back to issuer", testJWT(`{"iss":"https://auth.tesla.cn/oauth2/v3","ou_code":"XX"}`), config.RegionCN},
+		{"no claims", testJWT(`{}`), ""},
+		{"not a JWT", "opaque-token", ""},
+		{"bad payload", "a.!!!.c", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectRegion(tt.token); got != tt.want {
+				t.Errorf("DetectRegion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// hostPreservingTransport sends requests to target while keeping the
+// original Host header, so the test server can check which endpoint was used
+type hostPreservingTransport struct {
+	target string
+}
+
+func (rt hostPreservingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.Host = req.URL.Host
+	u, _ := url.Parse(rt.target)
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_RegionEndpoints(t *testing.T) {
+	var gotHost string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHost = r.Host
+		w.Write([]byte(`{"response": []}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		region   string
+		fleetAPI bool
+		wantHost string
+	}{
+		{config.RegionNA, false, "owner-api.teslamotors.com"},
+		{config.RegionEU, false, "owner-api.teslamotors.com"},
+		{config.RegionCN, false, "owner-api.vn.cloud.tesla.cn"},
+		{"", false, "owner-api.teslamotors.com"},
+		{config.RegionNA, true, "fleet-api.prd.na.vn.cloud.tesla.com"},
+		{config.RegionEU, true, "fleet-api.prd.eu.vn.cloud.tesla.com"},
+		{config.RegionCN, true, "fleet-api.prd.cn.vn.cloud.tesla.cn"},
+		{"mars", true, "fleet-api.prd.na.vn.cloud.tesla.com"},
+	}
+
+	for _, tt := range tests {
+		name := tt.region
+		if tt.fleetAPI {
+			name += "/fleet"
+		}
+		t.Run(name, func(t *testing.T) {
+			client := NewClient(nil)
+			client.httpClient = &http.Client{Transport: hostPreservingTransport{target: server.URL}}
+			client.SetRegion(tt.region)
+			client.SetFleetAPI(tt.fleetAPI)
+			client.SetTokens(&model.TeslaTokens{
+				AccessToken: "test-token",
+				ExpiresAt:   time.Now().Add(time.Hour),
+			})
+
+			if _, err := client.GetOrders(); err != nil {
+				t.Fatalf("GetOrders() error = %v", err)
+			}
+			if gotHost != tt.wantHost {
+				t.Errorf("Host = %s, want %s", gotHost, tt.wantHost)
+			}
+		})
+	}
+}
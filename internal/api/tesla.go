@@ -6,17 +6,45 @@ import (
 	"io"
 	"strings"
 
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
 )
 
+// Tesla serves account data from two APIs. The legacy owner API is what the
+// Tesla app has always used and still works with tokens from the app's OAuth
+// client. The Fleet API is its official successor; it exposes the same
+// /api/1 paths but only accepts tokens issued to a registered Fleet API
+// application. The tasks endpoint used for order details lives on Tesla's app
+// gateway and is the same for both.
 const (
-	ordersAPIURL            = "https://owner-api.teslamotors.com/api/1/users/orders"
+	// OwnerAPIBaseURL is the legacy owner API outside China
+	OwnerAPIBaseURL = "https://owner-api.teslamotors.com"
+	// FleetAPIBaseURL is the Fleet API for North America and Asia-Pacific
+	FleetAPIBaseURL = "https://fleet-api.prd.na.vn.cloud.tesla.com"
+
+	ordersAPIPath           = "/api/1/users/orders"
 	orderDetailsAPITemplate = "https://akamai-apigateway-vfx.tesla.com/tasks?deviceLanguage=en&deviceCountry=US&referenceNumber={ORDER_ID}&appVersion=9.99.9-9999"
 )
 
+// apiBaseURL returns the base URL for owner/Fleet API requests in the
+// client's region
+func (c *Client) apiBaseURL() string {
+	if c.FleetAPIBaseURL != "" {
+		return strings.TrimSuffix(c.FleetAPIBaseURL, "/")
+	}
+	region := c.region
+	if !config.ValidRegion(region) {
+		region = config.RegionNA
+	}
+	if c.fleetAPI {
+		return fleetAPIBaseURLs[region]
+	}
+	return ownerAPIBaseURLs[region]
+}
+
 // GetOrders fetches all orders for the authenticated user
 func (c *Client) GetOrders() ([]model.TeslaOrder, error) {
-	resp, err := c.Get(ordersAPIURL)
+	resp, err := c.Get(c.apiBaseURL() + ordersAPIPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch orders: %w", err)
 	}
@@ -30,7 +58,7 @@ func (c *Client) GetOrders() ([]model.TeslaOrder, error) {
 
 	// Check for API errors
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp.StatusCode, body)
 	}
 
 	// Tesla API wraps orders in a "response" field
@@ -42,6 +70,25 @@ func (c *Client) GetOrders() ([]model.TeslaOrder, error) {
 	return ordersResp.Response, nil
 }
 
+// CheckHealth makes a single orders request to verify that the saved tokens
+// are accepted and the API is reachable, without decoding the response
+func (c *Client) CheckHealth() error {
+	resp, err := c.Get(c.apiBaseURL() + ordersAPIPath)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newAPIError(resp.StatusCode, body)
+	}
+	return nil
+}
+
 // GetOrderDetails fetches detailed information for a specific order
 func (c *Client) GetOrderDetails(referenceNumber string) (*model.OrderDetails, error) {
 	url := strings.Replace(orderDetailsAPITemplate, "{ORDER_ID}", referenceNumber, 1)
@@ -60,7 +107,7 @@ func (c *Client) GetOrderDetails(referenceNumber string) (*model.OrderDetails, e
 
 	// Check for API errors
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp.StatusCode, body)
 	}
 
 	// Store raw JSON for display
@@ -116,6 +163,22 @@ func (c *Client) GetOrderDetails(referenceNumber string) (*model.OrderDetails, e
 				}
 			}
 
+			// Parse insurance task
+			if insuranceRaw, ok := tasksMap["insurance"]; ok {
+				var insurance model.TeslaTask
+				if err := json.Unmarshal(insuranceRaw, &insurance); err == nil {
+					details.Tasks.Insurance = &insurance
+				}
+			}
+
+			// Parse the stage completion state
+			if stateRaw, ok := tasksMap["state"]; ok {
+				var state model.TaskState
+				if err := json.Unmarshal(stateRaw, &state); err == nil {
+					details.Tasks.State = &state
+				}
+			}
+
 			// Store raw for tasks view
 			details.Tasks.Raw = tasksMap
 		}
@@ -149,14 +212,37 @@ func (c *Client) GetOrderDetails(referenceNumber string) (*model.OrderDetails, e
 			}
 		}
 
+		var insurance model.TeslaTask
+		if insuranceRaw, ok := rawTasks["insurance"]; ok {
+			if err := json.Unmarshal(insuranceRaw, &insurance); err == nil {
+				details.Tasks.Insurance = &insurance
+			}
+		}
+
+		var state model.TaskState
+		if stateRaw, ok := rawTasks["state"]; ok {
+			if err := json.Unmarshal(stateRaw, &state); err == nil {
+				details.Tasks.State = &state
+			}
+		}
+
 		details.Tasks.Raw = rawTasks
 	}
 
 	return details, nil
 }
 
-// GetAllOrderData fetches all orders with their details
-func (c *Client) GetAllOrderData() ([]model.CombinedOrder, error) {
+// GetAllOrderData fetches all orders with their details.
+// progress, if non-nil, is called after each order's details are fetched.
+func (c *Client) GetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error) {
+	return c.GetOrderDataFor(nil, progress)
+}
+
+// GetOrderDataFor fetches all orders, but only fetches details for the
+// orders include returns true for; the others come back with empty details.
+// A nil include fetches details for every order. progress, if non-nil, is
+// called after each order's details are fetched.
+func (c *Client) GetOrderDataFor(include func(referenceNumber string) bool, progress ProgressFunc) ([]model.CombinedOrder, error) {
 	orders, err := c.GetOrders()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get orders: %w", err)
@@ -166,9 +252,22 @@ func (c *Client) GetAllOrderData() ([]model.CombinedOrder, error) {
 		return []model.CombinedOrder{}, nil
 	}
 
+	total := 0
+	for _, order := range orders {
+		if include == nil || include(order.ReferenceNumber) {
+			total++
+		}
+	}
+
 	combinedOrders := make([]model.CombinedOrder, 0, len(orders))
+	fetched := 0
 
 	for _, order := range orders {
+		if include != nil && !include(order.ReferenceNumber) {
+			combinedOrders = append(combinedOrders, model.CombinedOrder{Order: order})
+			continue
+		}
+
 		details, err := c.GetOrderDetails(order.ReferenceNumber)
 		if err != nil {
 			// Log but continue with other orders
@@ -180,7 +279,20 @@ func (c *Client) GetAllOrderData() ([]model.CombinedOrder, error) {
 			Order:   order,
 			Details: *details,
 		})
+
+		fetched++
+		if progress != nil {
+			progress(fetched, total)
+		}
 	}
 
 	return combinedOrders, nil
 }
+
+// ForceGetAllOrderData fetches all orders like GetAllOrderData, but sends its
+// requests without waiting for the rate limiter
+func (c *Client) ForceGetAllOrderData(progress ProgressFunc) ([]model.CombinedOrder, error) {
+	c.forcing.Add(1)
+	defer c.forcing.Add(-1)
+	return c.GetAllOrderData(progress)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -12,6 +13,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
@@ -24,25 +27,178 @@ const (
 	tokensFile    = "tokens.enc"
 	keyFile       = "key"
 
+	// legacyDirName is where early versions kept their data, in the home
+	// directory
+	legacyDirName = ".tesla-tui"
+	historyDir    = "history"
+
+	// migrationNoticeFile holds a one-time notice about a token migration
+	// until the TUI has shown it
+	migrationNoticeFile = "migration-notice"
+
 	// Keyring identifiers
 	keyringService = "tesla-delivery-tui"
 	keyringUser    = "tokens"
 )
 
+// Notices recorded when tokens move between storage backends
+const (
+	MigratedToKeyringNotice = "Credentials migrated to OS keyring"
+	MigratedToFileNotice    = "Credentials moved from OS keyring to encrypted file"
+	MigratedLegacyNotice    = "Data moved from ~/.tesla-tui to the config directory"
+)
+
 // Config holds application configuration
 type Config struct {
 	configDir       string
+	legacyDir        string // data directory of early versions; empty when not migrating
 	keyringAvailable bool
+
+	// mu guards settings, which commands save from their own goroutines
+	mu       sync.Mutex
+	settings Settings
 }
 
-// New creates a new Config instance
-func New() (*Config, error) {
+// New creates a new Config instance. configDir overrides the default
+// location (~/.config/tesla-delivery-tui) when non-empty.
+func New(configDir string) (*Config, error) {
+	if configDir != "" {
+		return NewWithDir(configDir)
+	}
+
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get home directory: %w", err)
 	}
 
-	configDir := filepath.Join(homeDir, configDirName, appName)
+	c, err := NewWithDir(filepath.Join(homeDir, configDirName, appName))
+	if err != nil {
+		return nil, err
+	}
+	c.legacyDir = filepath.Join(homeDir, legacyDirName)
+	if err := c.MigrateFromLegacyLocation(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// MigrateFromLegacyLocation moves the tokens and history that early versions
+// stored in ~/.tesla-tui to the config directory. Files already present in
+// the config directory are kept. The old directory is only removed once
+// everything in it has been migrated; otherwise it stays in place for the
+// user to sort out. It does nothing when there is no legacy directory.
+func (c *Config) MigrateFromLegacyLocation() error {
+	if c.legacyDir == "" {
+		return nil
+	}
+	legacyEntries, err := os.ReadDir(c.legacyDir)
+	if err != nil {
+		return nil
+	}
+
+	complete, err := c.migrateLegacyTokens()
+	if err != nil {
+		return err
+	}
+
+	legacyHistory := filepath.Join(c.legacyDir, historyDir)
+	entries, err := os.ReadDir(legacyHistory)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read legacy history: %w", err)
+	}
+	if len(entries) > 0 {
+		if err := os.MkdirAll(filepath.Join(c.configDir, historyDir), 0700); err != nil {
+			return fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			complete = false
+			continue
+		}
+		src := filepath.Join(legacyHistory, entry.Name())
+		migrated, err := migrateFile(src, filepath.Join(c.configDir, historyDir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to migrate history: %w", err)
+		}
+		complete = complete && migrated
+	}
+
+	// Anything else in the legacy directory isn't ours to migrate
+	for _, entry := range legacyEntries {
+		switch entry.Name() {
+		case tokensFile, keyFile, historyDir:
+		default:
+			complete = false
+		}
+	}
+	if !complete {
+		return nil
+	}
+
+	if err := os.RemoveAll(c.legacyDir); err != nil {
+		return fmt.Errorf("failed to remove legacy directory: %w", err)
+	}
+	c.setMigrationNotice(MigratedLegacyNotice)
+	return nil
+}
+
+// migrateLegacyTokens copies tokens.enc and the key that decrypts it as a
+// pair. When the config directory already holds either file, neither is
+// copied, since mixing them would leave tokens that can't be decrypted.
+// Reports whether the legacy tokens are fully migrated.
+func (c *Config) migrateLegacyTokens() (bool, error) {
+	names := []string{tokensFile, keyFile}
+
+	var inLegacy bool
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(c.legacyDir, name)); err == nil {
+			inLegacy = true
+		}
+	}
+	if !inLegacy {
+		return true, nil
+	}
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(c.configDir, name)); err == nil {
+			return false, nil
+		}
+	}
+
+	for _, name := range names {
+		if _, err := migrateFile(filepath.Join(c.legacyDir, name), filepath.Join(c.configDir, name)); err != nil {
+			return false, fmt.Errorf("failed to migrate %s: %w", name, err)
+		}
+	}
+	return true, nil
+}
+
+// migrateFile copies src to dst with owner-only permissions, unless src
+// doesn't exist or dst already does. Reports whether src is safe to delete:
+// it was copied, didn't exist, or dst holds the same contents.
+func migrateFile(src, dst string) (bool, error) {
+	data, err := os.ReadFile(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	existing, err := os.ReadFile(dst)
+	if err == nil {
+		return bytes.Equal(existing, data), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if err := os.WriteFile(dst, data, 0600); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NewWithDir creates a new Config instance rooted at the given directory
+func NewWithDir(configDir string) (*Config, error) {
 	if err := os.MkdirAll(configDir, 0700); err != nil {
 		return nil, fmt.Errorf("failed to create config directory: %w", err)
 	}
@@ -52,6 +208,12 @@ func New() (*Config, error) {
 	// Test if keyring is available
 	c.keyringAvailable = c.testKeyring()
 
+	settings, err := c.loadSettings()
+	if err != nil {
+		return nil, err
+	}
+	c.settings = settings
+
 	return c, nil
 }
 
@@ -301,12 +463,52 @@ func (c *Config) LoadTokens() (*model.TeslaTokens, error) {
 		if err := c.saveTokensToKeyring(tokens); err == nil {
 			// Migration successful, remove file
 			c.deleteTokensFromFile()
+			c.setMigrationNotice(MigratedToKeyringNotice)
 		}
 	}
 
 	return tokens, nil
 }
 
+// DisableKeyring stops using the system keyring, moving any tokens stored
+// there to the encrypted file (--no-keyring)
+func (c *Config) DisableKeyring() error {
+	if !c.keyringAvailable {
+		return nil
+	}
+	c.keyringAvailable = false
+
+	tokens, err := c.loadTokensFromKeyring()
+	if err != nil || tokens == nil {
+		// Nothing to migrate
+		return nil
+	}
+	if err := c.saveTokensToFile(tokens); err != nil {
+		return fmt.Errorf("failed to move tokens to file: %w", err)
+	}
+	c.deleteTokensFromKeyring()
+	c.setMigrationNotice(MigratedToFileNotice)
+	return nil
+}
+
+// setMigrationNotice records a notice to show once on the next startup.
+// Failing to write it only loses the notice, so errors are ignored.
+func (c *Config) setMigrationNotice(notice string) {
+	os.WriteFile(filepath.Join(c.configDir, migrationNoticeFile), []byte(notice), 0600)
+}
+
+// TakeMigrationNotice returns the pending token migration notice, if any,
+// and clears it so it is only shown once
+func (c *Config) TakeMigrationNotice() string {
+	path := filepath.Join(c.configDir, migrationNoticeFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	os.Remove(path)
+	return strings.TrimSpace(string(data))
+}
+
 // DeleteTokens removes saved tokens (logout) from all storage
 func (c *Config) DeleteTokens() error {
 	var lastErr error
@@ -341,6 +543,20 @@ func (c *Config) HasTokens() bool {
 	return err == nil
 }
 
+// TokensDeleted reports whether the saved tokens are known to be gone: the
+// keyring has no entry and there is no tokens file. Other errors, such as a
+// locked keyring or an unreadable config directory, don't count as deleted.
+func (c *Config) TokensDeleted() bool {
+	if c.keyringAvailable {
+		if _, err := keyring.Get(keyringService, keyringUser); !errors.Is(err, keyring.ErrNotFound) {
+			return false
+		}
+	}
+
+	_, err := os.Stat(filepath.Join(c.configDir, tokensFile))
+	return errors.Is(err, os.ErrNotExist)
+}
+
 // GenerateCodeVerifier generates a PKCE code verifier
 func GenerateCodeVerifier() (string, error) {
 	b := make([]byte, 32)
@@ -2,16 +2,18 @@ package config
 
 import (
 	"encoding/base64"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+	"github.com/zalando/go-keyring"
 )
 
 func TestNew(t *testing.T) {
-	cfg, err := New()
+	cfg, err := New("")
 	if err != nil {
 		t.Fatalf("New() error = %v", err)
 	}
@@ -31,7 +33,7 @@ func TestNew(t *testing.T) {
 }
 
 func TestConfig_ConfigDir(t *testing.T) {
-	cfg, err := New()
+	cfg, err := New("")
 	if err != nil {
 		t.Fatalf("New() error = %v", err)
 	}
@@ -47,6 +49,39 @@ func TestConfig_ConfigDir(t *testing.T) {
 	}
 }
 
+func TestNew_ConfigDirOverride(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Point HOME elsewhere so we can verify nothing is written there
+	home := filepath.Join(tempDir, "home")
+	t.Setenv("HOME", home)
+
+	configDir := filepath.Join(tempDir, "custom")
+	cfg, err := New(configDir)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if cfg.ConfigDir() != configDir {
+		t.Errorf("ConfigDir() = %s, want %s", cfg.ConfigDir(), configDir)
+	}
+
+	if err := cfg.SaveSettings(DefaultSettings()); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(configDir, settingsFile)); err != nil {
+		t.Errorf("settings file not created in override dir: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(home, configDirName, appName)); !os.IsNotExist(err) {
+		t.Errorf("default config dir under $HOME should not be created, stat err = %v", err)
+	}
+}
+
 func TestConfig_EncryptDecrypt(t *testing.T) {
 	// Create a temp directory for this test
 	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
@@ -327,6 +362,42 @@ func TestConfig_HasTokens(t *testing.T) {
 	}
 }
 
+func TestConfig_TokensDeleted(t *testing.T) {
+	tempDir := t.TempDir()
+
+	keyring.MockInit()
+	cfg := &Config{configDir: tempDir, keyringAvailable: true}
+	if !cfg.TokensDeleted() {
+		t.Error("TokensDeleted() = false, want true (nothing saved)")
+	}
+
+	if err := cfg.SaveTokens(&model.TeslaTokens{AccessToken: "test"}); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+	if cfg.TokensDeleted() {
+		t.Error("TokensDeleted() = true, want false (tokens in keyring)")
+	}
+
+	// A keyring that can't be read is not the same as deleted tokens
+	keyring.MockInitWithError(errors.New("keyring locked"))
+	defer keyring.MockInit()
+	if cfg.TokensDeleted() {
+		t.Error("TokensDeleted() = true, want false (keyring error)")
+	}
+
+	// Without a keyring only a missing tokens file counts
+	fileCfg := &Config{configDir: tempDir, keyringAvailable: false}
+	if !fileCfg.TokensDeleted() {
+		t.Error("TokensDeleted() = false, want true (no tokens file)")
+	}
+	if err := fileCfg.SaveTokens(&model.TeslaTokens{AccessToken: "test"}); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+	if fileCfg.TokensDeleted() {
+		t.Error("TokensDeleted() = true, want false (tokens file saved)")
+	}
+}
+
 func TestConfig_TokenFilePermissions(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
 	if err != nil {
@@ -495,3 +566,231 @@ func TestConfig_IsKeyringAvailable(t *testing.T) {
 		t.Error("IsKeyringAvailable() = true, want false")
 	}
 }
+
+func TestConfig_LoadTokens_MigratesToKeyring(t *testing.T) {
+	keyring.MockInit()
+
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Tokens saved while the keyring was unavailable
+	fileCfg := &Config{configDir: tempDir, keyringAvailable: false}
+	tokens := &model.TeslaTokens{AccessToken: "access123", RefreshToken: "refresh456"}
+	if err := fileCfg.SaveTokens(tokens); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+
+	cfg := &Config{configDir: tempDir, keyringAvailable: true}
+	loaded, err := cfg.LoadTokens()
+	if err != nil {
+		t.Fatalf("LoadTokens() error = %v", err)
+	}
+	if loaded == nil || loaded.AccessToken != tokens.AccessToken {
+		t.Fatalf("LoadTokens() = %+v, want access token %q", loaded, tokens.AccessToken)
+	}
+
+	if _, err := os.Stat(filepath.Join(tempDir, tokensFile)); !os.IsNotExist(err) {
+		t.Error("token file should be removed after migration")
+	}
+	if inKeyring, err := cfg.loadTokensFromKeyring(); err != nil || inKeyring.AccessToken != tokens.AccessToken {
+		t.Errorf("loadTokensFromKeyring() = %+v, %v, want migrated tokens", inKeyring, err)
+	}
+
+	if got := cfg.TakeMigrationNotice(); got != MigratedToKeyringNotice {
+		t.Errorf("TakeMigrationNotice() = %q, want %q", got, MigratedToKeyringNotice)
+	}
+	if got := cfg.TakeMigrationNotice(); got != "" {
+		t.Errorf("second TakeMigrationNotice() = %q, want empty", got)
+	}
+
+	// Loading again from the keyring is not a migration
+	if _, err := cfg.LoadTokens(); err != nil {
+		t.Fatalf("LoadTokens() error = %v", err)
+	}
+	if got := cfg.TakeMigrationNotice(); got != "" {
+		t.Errorf("TakeMigrationNotice() after keyring load = %q, want empty", got)
+	}
+}
+
+func TestConfig_DisableKeyring(t *testing.T) {
+	keyring.MockInit()
+
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg := &Config{configDir: tempDir, keyringAvailable: true}
+	tokens := &model.TeslaTokens{AccessToken: "access123", RefreshToken: "refresh456"}
+	if err := cfg.SaveTokens(tokens); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+
+	if err := cfg.DisableKeyring(); err != nil {
+		t.Fatalf("DisableKeyring() error = %v", err)
+	}
+	if cfg.IsKeyringAvailable() {
+		t.Error("IsKeyringAvailable() = true after DisableKeyring()")
+	}
+	if _, err := cfg.loadTokensFromKeyring(); err == nil {
+		t.Error("tokens should be removed from the keyring")
+	}
+
+	loaded, err := cfg.LoadTokens()
+	if err != nil {
+		t.Fatalf("LoadTokens() error = %v", err)
+	}
+	if loaded == nil || loaded.AccessToken != tokens.AccessToken {
+		t.Errorf("LoadTokens() = %+v, want access token %q", loaded, tokens.AccessToken)
+	}
+	if got := cfg.TakeMigrationNotice(); got != MigratedToFileNotice {
+		t.Errorf("TakeMigrationNotice() = %q, want %q", got, MigratedToFileNotice)
+	}
+
+	// Disabling again is a no-op
+	if err := cfg.DisableKeyring(); err != nil {
+		t.Errorf("second DisableKeyring() error = %v", err)
+	}
+	if got := cfg.TakeMigrationNotice(); got != "" {
+		t.Errorf("TakeMigrationNotice() = %q, want empty", got)
+	}
+}
+
+func TestConfig_MigrateFromLegacyLocation(t *testing.T) {
+	root := t.TempDir()
+	legacyDir := filepath.Join(root, ".tesla-tui")
+	configDir := filepath.Join(root, ".config", "tesla-delivery-tui")
+
+	// Legacy data: encrypted tokens with their key, and a history file
+	legacy := &Config{configDir: legacyDir, keyringAvailable: false}
+	if err := os.MkdirAll(filepath.Join(legacyDir, "history"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := legacy.SaveTokens(&model.TeslaTokens{AccessToken: "legacy-access", ExpiresIn: 3600}); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+	historyJSON := []byte(`{"referenceNumber": "RN123", "snapshots": []}`)
+	if err := os.WriteFile(filepath.Join(legacyDir, "history", "RN123.json"), historyJSON, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &Config{configDir: configDir, legacyDir: legacyDir, keyringAvailable: false}
+	if err := cfg.MigrateFromLegacyLocation(); err != nil {
+		t.Fatalf("MigrateFromLegacyLocation() error = %v", err)
+	}
+
+	tokens, err := cfg.LoadTokens()
+	if err != nil || tokens == nil || tokens.AccessToken != "legacy-access" {
+		t.Errorf("LoadTokens() = %+v, %v; want the legacy tokens", tokens, err)
+	}
+	got, err := os.ReadFile(filepath.Join(configDir, "history", "RN123.json"))
+	if err != nil || string(got) != string(historyJSON) {
+		t.Errorf("migrated history = %q, %v", got, err)
+	}
+	if _, err := os.Stat(legacyDir); !os.IsNotExist(err) {
+		t.Errorf("legacy directory should be removed, Stat() error = %v", err)
+	}
+	if notice := cfg.TakeMigrationNotice(); notice != MigratedLegacyNotice {
+		t.Errorf("TakeMigrationNotice() = %q, want %q", notice, MigratedLegacyNotice)
+	}
+
+	// Nothing left to migrate
+	if err := cfg.MigrateFromLegacyLocation(); err != nil {
+		t.Errorf("second MigrateFromLegacyLocation() error = %v", err)
+	}
+}
+
+func TestConfig_MigrateFromLegacyLocation_KeepsNewerFiles(t *testing.T) {
+	root := t.TempDir()
+	legacyDir := filepath.Join(root, ".tesla-tui")
+	configDir := filepath.Join(root, "config")
+	for _, dir := range []string{legacyDir, configDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(legacyDir, "tokens.enc"), []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "tokens.enc"), []byte("new"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{configDir: configDir, legacyDir: legacyDir}
+	if err := cfg.MigrateFromLegacyLocation(); err != nil {
+		t.Fatalf("MigrateFromLegacyLocation() error = %v", err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(configDir, "tokens.enc")); string(got) != "new" {
+		t.Errorf("tokens.enc = %q, want the existing file kept", got)
+	}
+	if got, err := os.ReadFile(filepath.Join(legacyDir, "tokens.enc")); err != nil || string(got) != "old" {
+		t.Errorf("legacy tokens.enc = %q, %v; want it kept since it wasn't migrated", got, err)
+	}
+}
+
+func TestConfig_MigrateFromLegacyLocation_KeyAlreadyExists(t *testing.T) {
+	root := t.TempDir()
+	legacyDir := filepath.Join(root, ".tesla-tui")
+	configDir := filepath.Join(root, "config")
+	for _, dir := range []string{legacyDir, configDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, data := range map[string]string{"tokens.enc": "legacy-tokens", "key": "legacy-key"} {
+		if err := os.WriteFile(filepath.Join(legacyDir, name), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "key"), []byte("new-key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{configDir: configDir, legacyDir: legacyDir}
+	if err := cfg.MigrateFromLegacyLocation(); err != nil {
+		t.Fatalf("MigrateFromLegacyLocation() error = %v", err)
+	}
+
+	// The legacy tokens can't be decrypted with the new key, so they stay put
+	if _, err := os.Stat(filepath.Join(configDir, "tokens.enc")); !os.IsNotExist(err) {
+		t.Errorf("tokens.enc should not be copied next to a different key, Stat() error = %v", err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(configDir, "key")); string(got) != "new-key" {
+		t.Errorf("key = %q, want the existing key kept", got)
+	}
+	for _, name := range []string{"tokens.enc", "key"} {
+		if _, err := os.Stat(filepath.Join(legacyDir, name)); err != nil {
+			t.Errorf("legacy %s should be kept, Stat() error = %v", name, err)
+		}
+	}
+	if notice := cfg.TakeMigrationNotice(); notice != "" {
+		t.Errorf("TakeMigrationNotice() = %q, want none for an incomplete migration", notice)
+	}
+}
+
+func TestConfig_MigrateFromLegacyLocation_KeepsUnknownFiles(t *testing.T) {
+	root := t.TempDir()
+	legacyDir := filepath.Join(root, ".tesla-tui")
+	configDir := filepath.Join(root, "config")
+	if err := os.MkdirAll(legacyDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(legacyDir, "notes.txt"), []byte("mine"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{configDir: configDir, legacyDir: legacyDir}
+	if err := cfg.MigrateFromLegacyLocation(); err != nil {
+		t.Fatalf("MigrateFromLegacyLocation() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(legacyDir, "notes.txt")); err != nil {
+		t.Errorf("files that aren't migrated should be kept, Stat() error = %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/format"
+)
+
+const settingsFile = "settings.json"
+
+// API regions. Tesla accounts live in one region and must use its endpoints.
+const (
+	RegionNA = "na" // North America and the rest of the world
+	RegionEU = "eu" // Europe
+	RegionCN = "cn" // China
+)
+
+// Regions lists the valid API regions
+var Regions = []string{RegionNA, RegionEU, RegionCN}
+
+// ValidRegion reports whether region is one of Regions
+func ValidRegion(region string) bool {
+	for _, r := range Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// Settings holds user preferences persisted across sessions
+type Settings struct {
+	AutoRefreshInterval  time.Duration `json:"autoRefreshInterval,omitempty"`
+	MaxHistoryEntries    int           `json:"maxHistoryEntries,omitempty"`
+	NotificationsEnabled bool          `json:"notificationsEnabled"`
+	Timezone             string        `json:"timezone,omitempty"`          // IANA name, e.g. Europe/Amsterdam
+	Units                string        `json:"units,omitempty"`             // "metric", "imperial" or empty for API units
+	VisibleColumns       []string      `json:"visibleColumns,omitempty"`    // orders table columns; empty shows the defaults
+	ToastDuration        time.Duration `json:"toastDuration,omitempty"`     // how long notifications stay visible
+	Region               string        `json:"region,omitempty"`            // API region; empty until detected from the login token
+	DateFormat           string        `json:"dateFormat,omitempty"`        // one of format.DateFormats
+	SoundAlert           bool          `json:"soundAlert,omitempty"`        // ring the terminal bell on VIN or appointment changes
+	WatchedOrders        []string      `json:"watchedOrders,omitempty"`     // reference numbers auto-refresh updates; empty updates all
+	MinTerminalWidth     int           `json:"minTerminalWidth,omitempty"`  // narrower terminals show a "too small" warning
+	MinTerminalHeight    int           `json:"minTerminalHeight,omitempty"` // shorter terminals show a "too small" warning
+}
+
+// DefaultSettings returns the settings used when nothing has been saved
+func DefaultSettings() Settings {
+	return Settings{
+		AutoRefreshInterval:  5 * time.Minute,
+		MaxHistoryEntries:    20,
+		NotificationsEnabled: true,
+		ToastDuration:        3 * time.Second,
+		DateFormat:           format.DateLongUS,
+		MinTerminalWidth:     80,
+		MinTerminalHeight:    24,
+	}
+}
+
+// loadSettings reads settings from disk, returning defaults if none are saved
+func (c *Config) loadSettings() (Settings, error) {
+	settings := DefaultSettings()
+
+	data, err := os.ReadFile(filepath.Join(c.configDir, settingsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return settings, nil
+		}
+		return settings, fmt.Errorf("failed to read settings file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("failed to parse settings file: %w", err)
+	}
+
+	return settings, nil
+}
+
+// Settings returns the current user settings. The slices are copies, so
+// callers may change them before passing the settings to SaveSettings.
+func (c *Config) Settings() Settings {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	settings := c.settings
+	settings.VisibleColumns = slices.Clone(settings.VisibleColumns)
+	settings.WatchedOrders = slices.Clone(settings.WatchedOrders)
+	return settings
+}
+
+// SaveSettings persists the given settings and makes them current
+func (c *Config) SaveSettings(settings Settings) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal settings: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(c.configDir, settingsFile), data, 0600); err != nil {
+		return fmt.Errorf("failed to write settings file: %w", err)
+	}
+
+	c.settings = settings
+	return nil
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSettings_DefaultsWhenMissing(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	if got := cfg.Settings(); !reflect.DeepEqual(got, DefaultSettings()) {
+		t.Errorf("Settings() = %+v, want defaults %+v", got, DefaultSettings())
+	}
+}
+
+func TestSettings_PersistAcrossInstances(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	settings := cfg.Settings()
+	settings.AutoRefreshInterval = 12 * time.Minute
+	settings.MaxHistoryEntries = 50
+	settings.NotificationsEnabled = false
+	settings.VisibleColumns = []string{"Model", "Status", "Ready Score"}
+	if err := cfg.SaveSettings(settings); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+
+	if got := cfg.Settings().AutoRefreshInterval; got != 12*time.Minute {
+		t.Errorf("AutoRefreshInterval after save = %v, want 12m", got)
+	}
+
+	// A fresh Config should pick up the saved value
+	reloaded, err := NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() reload error = %v", err)
+	}
+	if got := reloaded.Settings(); !reflect.DeepEqual(got, settings) {
+		t.Errorf("Settings() after reload = %+v, want %+v", got, settings)
+	}
+}
+
+func TestSettings_PartialFileKeepsDefaults(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Settings saved by an older version only contain the interval
+	data := []byte(`{"autoRefreshInterval": 600000000000}`)
+	if err := os.WriteFile(filepath.Join(tempDir, settingsFile), data, 0600); err != nil {
+		t.Fatalf("Failed to write settings file: %v", err)
+	}
+
+	cfg, err := NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	got := cfg.Settings()
+	if got.AutoRefreshInterval != 10*time.Minute {
+		t.Errorf("AutoRefreshInterval = %v, want 10m", got.AutoRefreshInterval)
+	}
+	if got.MaxHistoryEntries != DefaultSettings().MaxHistoryEntries {
+		t.Errorf("MaxHistoryEntries = %d, want default", got.MaxHistoryEntries)
+	}
+	if !got.NotificationsEnabled {
+		t.Error("NotificationsEnabled should default to true")
+	}
+	if got.ToastDuration != 3*time.Second {
+		t.Errorf("ToastDuration = %v, want 3s", got.ToastDuration)
+	}
+	if got.DateFormat != "long-us" {
+		t.Errorf("DateFormat = %q, want long-us", got.DateFormat)
+	}
+}
+
+func TestSettings_FilePermissions(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg := &Config{configDir: tempDir}
+	if err := cfg.SaveSettings(Settings{AutoRefreshInterval: time.Minute}); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(tempDir, settingsFile))
+	if err != nil {
+		t.Fatalf("Failed to stat settings file: %v", err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("Settings file permissions = %o, want 0600", mode)
+	}
+}
+
+func TestSettings_InvalidFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, settingsFile), []byte("not json"), 0600); err != nil {
+		t.Fatalf("Failed to write settings file: %v", err)
+	}
+
+	if _, err := NewWithDir(tempDir); err == nil {
+		t.Error("NewWithDir() should fail on an invalid settings file")
+	}
+}
+
+func TestSettings_ConcurrentSaveAndRead(t *testing.T) {
+	cfg, err := NewWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	// Commands save settings from their own goroutines while Update reads
+	// them; run with -race to catch unsynchronized access
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			settings := cfg.Settings()
+			settings.MaxHistoryEntries = i + 1
+			settings.WatchedOrders = append(settings.WatchedOrders, "RN123")
+			if err := cfg.SaveSettings(settings); err != nil {
+				t.Errorf("SaveSettings() error = %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			_ = cfg.Settings().MaxHistoryEntries
+		}()
+	}
+	wg.Wait()
+
+	if got := cfg.Settings().MaxHistoryEntries; got < 1 || got > 8 {
+		t.Errorf("MaxHistoryEntries = %d, want one of the saved values", got)
+	}
+}
@@ -10,7 +10,7 @@ import (
 // GetDemoOrders returns mock order data for demo/recording purposes
 func GetDemoOrders() []model.CombinedOrder {
 	// Model Y VIN: XP7 (Berlin) + Y (Model Y) + A (SUV LHD) + C + E (Electric) + F (LR AWD) + 9 + T (2026) + B (Berlin) + 123456
-	vin := "XP7YACEF9TB123456"
+	vin := "XP7YACEF5TB123456"
 	mktOptions := "APBS,IPB11,PPSW,SC04,MDLY,WY19P,MTY52,STY5S,CPF0,TW01"
 
 	return []model.CombinedOrder{
@@ -78,6 +78,8 @@ func GetDemoOrders() []model.CombinedOrder {
 						Data: &model.FinalPaymentData{
 							ETAToDeliveryCenter: "June 10, 2026",
 						},
+						AmountDue:      "39120",
+						CurrencyFormat: &model.CurrencyFormat{CurrencyCode: "EUR"},
 					},
 					DeliveryDetails: &model.DeliveryDetailsTask{
 						TeslaTask: model.TeslaTask{
@@ -95,6 +97,17 @@ func GetDemoOrders() []model.CombinedOrder {
 							ReggieLicensePlate: "AB-123-CD",
 						},
 					},
+					Insurance: &model.TeslaTask{
+						ID:       "insurance",
+						Complete: false,
+						Enabled:  true,
+						Required: false,
+						Order:    6,
+						Card: &model.TeslaTaskCard{
+							Title:    "Insurance",
+							Subtitle: "Add insurance before delivery",
+						},
+					},
 					Raw: createDemoTasksRaw(),
 				},
 				RawJSON: createDemoRawJSON(),
@@ -238,6 +251,26 @@ func createDemoTasksRaw() map[string]json.RawMessage {
 	return raw
 }
 
+// GetDemoUsedOrder returns a mock order for a pre-owned inventory vehicle
+func GetDemoUsedOrder() model.CombinedOrder {
+	return scenarioSpec{
+		ref: "RN600000007", status: "BOOKED", modelCode: "m3",
+		// Model 3 VIN: LRW (Shanghai) + 3 + E + 7 + E + K + 2 + N (2022) + C + 654321
+		vin:     "LRW3E7EK2NC654321",
+		options: "APBS,IPB1,PMNG,SC05,MDL3,W38B,MT322,CPF0",
+		preOwned: map[string]interface{}{
+			"modelYear": 2022,
+			"condition": "Excellent",
+		},
+		window: "Jun 2026", deliveryType: "PICKUP_SERVICE_CENTER", center: "Utrecht - Eendrachtlaan",
+		location: "Utrecht - Eendrachtlaan",
+		odometer: "24500", odometerUnit: "km",
+		reservationDate: "2026-05-28", bookedDate: "2026-05-28",
+		amountDue: 31900, currency: "EUR",
+		registrationDone: true,
+	}.build()
+}
+
 // GetDemoDiffs returns mock diffs showing recent changes
 func GetDemoDiffs() map[string][]model.OrderDiff {
 	return map[string][]model.OrderDiff{
@@ -255,7 +288,7 @@ func GetDemoDiffs() map[string][]model.OrderDiff {
 			{
 				Field:    "VIN",
 				OldValue: "N/A",
-				NewValue: "XP7YACEF9TB123456",
+				NewValue: "XP7YACEF5TB123456",
 			},
 		},
 	}
@@ -264,7 +297,7 @@ func GetDemoDiffs() map[string][]model.OrderDiff {
 // GetDemoHistory returns mock history data
 func GetDemoHistory() map[string]*model.OrderHistory {
 	vin1 := ""
-	vin2 := "XP7YACEF9TB123456"
+	vin2 := "XP7YACEF5TB123456"
 	mktOptions := "APBS,IPB11,PPSW,SC04,MDLY,WY19P,MTY52,STY5S,CPF0,TW01"
 
 	return map[string]*model.OrderHistory{
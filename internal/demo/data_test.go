@@ -2,6 +2,8 @@ package demo
 
 import (
 	"testing"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
 )
 
 func TestGetDemoOrders(t *testing.T) {
@@ -130,6 +132,21 @@ func TestGetDemoOrders_RegistrationTask(t *testing.T) {
 	}
 }
 
+func TestGetDemoUsedOrder(t *testing.T) {
+	order := GetDemoUsedOrder()
+	if !order.GetIsUsed() {
+		t.Fatal("GetIsUsed() = false, want true")
+	}
+	if year, condition := order.GetUsedVehicleDetails(); year != 2022 || condition != "Excellent" {
+		t.Errorf("GetUsedVehicleDetails() = %d, %q; want 2022, %q", year, condition, "Excellent")
+	}
+	for _, demoOrder := range GetDemoOrders() {
+		if demoOrder.GetIsUsed() {
+			t.Errorf("%s: default demo orders should be new vehicles", demoOrder.Order.ReferenceNumber)
+		}
+	}
+}
+
 func TestGetDemoDiffs(t *testing.T) {
 	diffs := GetDemoDiffs()
 
@@ -300,3 +317,21 @@ func TestGetDemoOrders_MktOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestGetDemoOrders_Stats(t *testing.T) {
+	orders := GetDemoOrders()
+	stats := model.ComputeStats(orders)
+
+	if stats.TotalOrders != len(orders) {
+		t.Errorf("TotalOrders = %d, want %d", stats.TotalOrders, len(orders))
+	}
+	if stats.ByStatus["BOOKED"] == 0 {
+		t.Errorf("ByStatus = %v, want BOOKED counted", stats.ByStatus)
+	}
+	if stats.EarliestDelivery == nil {
+		t.Error("EarliestDelivery should be parsed from the demo appointment")
+	}
+	if stats.TotalAmountDue != 39120 {
+		t.Errorf("TotalAmountDue = %d, want 39120", stats.TotalAmountDue)
+	}
+}
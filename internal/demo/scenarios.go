@@ -0,0 +1,317 @@
+package demo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// DefaultScenario is the scenario shown by --demo
+const DefaultScenario = "model-y"
+
+// Scenarios lists the available demo scenarios
+var Scenarios = []string{DefaultScenario, "model-3", "cybertruck", "model-s-delivered", "model-x-b2b"}
+
+// GetDemoScenario returns the orders, pending diffs and history for a named
+// demo scenario. An empty name selects DefaultScenario.
+func GetDemoScenario(name string) ([]model.CombinedOrder, map[string][]model.OrderDiff, map[string]*model.OrderHistory, error) {
+	switch name {
+	case "", DefaultScenario:
+		return GetDemoOrders(), GetDemoDiffs(), GetDemoHistory(), nil
+	case "model-3":
+		return model3Scenario()
+	case "cybertruck":
+		return cybertruckScenario()
+	case "model-s-delivered":
+		return modelSDeliveredScenario()
+	case "model-x-b2b":
+		return modelXB2BScenario()
+	default:
+		return nil, nil, nil, fmt.Errorf("unknown demo scenario %q (available: %s)", name, strings.Join(Scenarios, ", "))
+	}
+}
+
+// scenarioSpec describes a demo order; build turns it into a CombinedOrder
+// with both typed and raw task data, like the API client produces
+type scenarioSpec struct {
+	ref, status, modelCode, vin, options string
+	company                              string                 // set for B2B orders
+	preOwned                             map[string]interface{} // set for used inventory orders
+
+	window, appointment, deliveryType, center string
+	location, eta                             string
+	odometer, odometerUnit                    string
+	reservationDate, bookedDate               string
+	plate                                     string
+	amountDue                                 int
+	currency                                  string
+
+	schedulingDone, registrationDone, paymentDone, insuranceDone bool
+}
+
+// scenarioTask builds a raw task with its card
+func scenarioTask(id string, order int, complete, required bool, title, subtitle string, extra map[string]interface{}) map[string]interface{} {
+	task := map[string]interface{}{
+		"id":       id,
+		"complete": complete,
+		"enabled":  true,
+		"required": required,
+		"order":    order,
+		"card": map[string]interface{}{
+			"title":    title,
+			"subtitle": subtitle,
+		},
+	}
+	for k, v := range extra {
+		task[k] = v
+	}
+	return task
+}
+
+func (s scenarioSpec) build() model.CombinedOrder {
+	order := model.TeslaOrder{
+		ReferenceNumber: s.ref,
+		OrderStatus:     s.status,
+		ModelCode:       s.modelCode,
+		IsB2B:           s.company != "",
+		IsUsed:          s.preOwned != nil,
+	}
+	if s.vin != "" {
+		vin := s.vin
+		order.VIN = &vin
+	}
+	if s.options != "" {
+		options := s.options
+		order.MktOptions = &options
+	}
+	if s.company != "" {
+		company := s.company
+		order.OwnerCompanyName = &company
+	}
+
+	scheduling := map[string]interface{}{
+		"deliveryWindowDisplay": s.window,
+		"deliveryType":          s.deliveryType,
+		"deliveryAddressTitle":  s.center,
+	}
+	if s.appointment != "" {
+		scheduling["apptDateTimeAddressStr"] = s.appointment
+	}
+
+	orderDetails := map[string]interface{}{
+		"vehicleRoutingLocation": s.location,
+		"reservationDate":        s.reservationDate,
+		"orderBookedDate":        s.bookedDate,
+		"currencyFormat":         map[string]interface{}{"currencyCode": s.currency},
+	}
+	if s.preOwned != nil {
+		orderDetails["preOwned"] = s.preOwned
+	}
+	if s.odometer != "" {
+		orderDetails["vehicleOdometer"] = s.odometer
+		orderDetails["vehicleOdometerType"] = s.odometerUnit
+	}
+
+	payment := map[string]interface{}{
+		"amountDue":      s.amountDue,
+		"currencyFormat": map[string]interface{}{"currencyCode": s.currency},
+	}
+	if s.eta != "" {
+		payment["data"] = map[string]interface{}{"etaToDeliveryCenter": s.eta}
+	}
+
+	tasks := map[string]interface{}{
+		"scheduling":   scenarioTask("scheduling", 1, s.schedulingDone, true, "Schedule Delivery", "Pick your delivery date", scheduling),
+		"registration": scenarioTask("registration", 2, s.registrationDone, true, "Registration", "Confirm registration details", map[string]interface{}{"orderDetails": orderDetails}),
+		"finalPayment": scenarioTask("finalPayment", 3, s.paymentDone, true, "Final Payment", "Complete your payment before delivery", payment),
+		"insurance":    scenarioTask("insurance", 4, s.insuranceDone, false, "Insurance", "Add insurance before delivery", nil),
+	}
+	if s.plate != "" {
+		tasks["deliveryDetails"] = scenarioTask("deliveryDetails", 5, true, false, "Delivery Details", "Review delivery information",
+			map[string]interface{}{"regData": map[string]interface{}{"reggieLicensePlate": s.plate}})
+	}
+
+	raw := make(map[string]json.RawMessage)
+	for name, task := range tasks {
+		data, _ := json.Marshal(task)
+		raw[name] = data
+	}
+
+	details := model.OrderDetails{Tasks: model.OrderTasks{Raw: raw}}
+	decode := func(name string, target interface{}) bool {
+		data, ok := raw[name]
+		return ok && json.Unmarshal(data, target) == nil
+	}
+	var sched model.SchedulingTask
+	if decode("scheduling", &sched) {
+		details.Tasks.Scheduling = &sched
+	}
+	var reg model.RegistrationTask
+	if decode("registration", &reg) {
+		details.Tasks.Registration = &reg
+	}
+	var fp model.FinalPaymentTask
+	if decode("finalPayment", &fp) {
+		details.Tasks.FinalPayment = &fp
+	}
+	var dd model.DeliveryDetailsTask
+	if decode("deliveryDetails", &dd) {
+		details.Tasks.DeliveryDetails = &dd
+	}
+	var ins model.TeslaTask
+	if decode("insurance", &ins) {
+		details.Tasks.Insurance = &ins
+	}
+
+	rawJSON, _ := json.Marshal(map[string]interface{}{"tasks": tasks})
+	json.Unmarshal(rawJSON, &details.RawJSON)
+
+	return model.CombinedOrder{Order: order, Details: details}
+}
+
+// scenarioHistory builds a history from specs, spaced days apart and ending
+// yesterday
+func scenarioHistory(specs ...scenarioSpec) *model.OrderHistory {
+	history := &model.OrderHistory{ReferenceNumber: specs[0].ref}
+	for i, spec := range specs {
+		daysAgo := len(specs) - i
+		history.Snapshots = append(history.Snapshots, model.HistoricalSnapshot{
+			Timestamp: time.Now().Add(-time.Duration(daysAgo) * 24 * time.Hour),
+			Data:      spec.build(),
+		})
+	}
+	return history
+}
+
+// model3Scenario: a Shanghai-built Model 3 in transit to Europe, waiting for
+// a delivery appointment
+func model3Scenario() ([]model.CombinedOrder, map[string][]model.OrderDiff, map[string]*model.OrderHistory, error) {
+	current := scenarioSpec{
+		ref: "RN200000003", status: "BOOKED", modelCode: "m3",
+		vin:     "LRW3E7EK1RC123456",
+		options: "APBS,IPW8,PN01,SC05,MDL3,W38A,MT354,STY3P,CPF0",
+		window:  "Jul - Aug 2026", deliveryType: "PICKUP_SERVICE_CENTER", center: "Amsterdam - Sloterdijk",
+		location: "In transit to Zeebrugge", eta: "July 20, 2026",
+		reservationDate: "2026-02-02", bookedDate: "2026-02-02",
+		amountDue: 42990, currency: "EUR",
+		registrationDone: true,
+	}
+	previous := current
+	previous.location = "Shanghai Factory"
+	previous.eta = "July 28, 2026"
+	first := previous
+	first.vin = ""
+	first.location = ""
+	first.eta = ""
+
+	diffs := map[string][]model.OrderDiff{
+		current.ref: {
+			{Field: "ETA to Delivery Center", OldValue: previous.eta, NewValue: current.eta},
+			{Field: "Vehicle Location", OldValue: previous.location, NewValue: current.location},
+		},
+	}
+	history := map[string]*model.OrderHistory{current.ref: scenarioHistory(first, previous, current)}
+	return []model.CombinedOrder{current.build()}, diffs, history, nil
+}
+
+// cybertruckScenario: a US Cybertruck reservation that has just been booked,
+// without a VIN yet
+func cybertruckScenario() ([]model.CombinedOrder, map[string][]model.OrderDiff, map[string]*model.OrderHistory, error) {
+	current := scenarioSpec{
+		ref: "RN300000004", status: "BOOKED", modelCode: "ct",
+		options: "MDLC,PBST,WC20A",
+		window:  "Sep - Oct 2026", deliveryType: "PICKUP_SERVICE_CENTER", center: "Austin - Gigafactory Texas",
+		reservationDate: "2019-11-22", bookedDate: "2026-05-30",
+		amountDue: 79990, currency: "USD",
+	}
+	previous := current
+	previous.status = "RESERVED"
+	previous.window = ""
+
+	diffs := map[string][]model.OrderDiff{
+		current.ref: {
+			{Field: "Order Status", OldValue: previous.status, NewValue: current.status},
+			{Field: "Delivery Window", OldValue: "N/A", NewValue: current.window},
+		},
+	}
+	history := map[string]*model.OrderHistory{current.ref: scenarioHistory(previous, current)}
+	return []model.CombinedOrder{current.build()}, diffs, history, nil
+}
+
+// modelSDeliveredScenario: a delivered Model S with every task complete
+func modelSDeliveredScenario() ([]model.CombinedOrder, map[string][]model.OrderDiff, map[string]*model.OrderHistory, error) {
+	current := scenarioSpec{
+		ref: "RN400000005", status: "DELIVERED", modelCode: "ms",
+		vin:     "5YJSA7E21RF123456",
+		options: "APBS,DV4W,PPSB,MDLS,WS90,IBE00",
+		window:  "Apr - May 2026", deliveryType: "PICKUP_SERVICE_CENTER", center: "Utrecht - Eendrachtlaan",
+		appointment: "May 4, 2026 at 2:30 PM - Tesla Delivery Center Utrecht, Eendrachtlaan 10",
+		location:    "Utrecht - Eendrachtlaan", eta: "April 28, 2026",
+		odometer: "12", odometerUnit: "km",
+		reservationDate: "2026-01-10", bookedDate: "2026-01-10",
+		plate:          "S-123-TL",
+		currency:       "EUR",
+		schedulingDone: true, registrationDone: true, paymentDone: true, insuranceDone: true,
+	}
+	ready := current
+	ready.status = "BOOKED"
+	ready.paymentDone = false
+	ready.amountDue = 96990
+	ready.odometer = ""
+	transit := ready
+	transit.appointment = ""
+	transit.schedulingDone = false
+	transit.location = "Fremont Factory"
+
+	diffs := map[string][]model.OrderDiff{
+		current.ref: {
+			{Field: "Order Status", OldValue: ready.status, NewValue: current.status},
+			{Field: "Odometer", OldValue: "N/A", NewValue: "12 km"},
+			{Field: "Amount Due", OldValue: "96990 EUR", NewValue: "0 EUR"},
+		},
+	}
+	history := map[string]*model.OrderHistory{current.ref: scenarioHistory(transit, ready, current)}
+	return []model.CombinedOrder{current.build()}, diffs, history, nil
+}
+
+// modelXB2BScenario: two company-owned Model X orders at different stages
+func modelXB2BScenario() ([]model.CombinedOrder, map[string][]model.OrderDiff, map[string]*model.OrderHistory, error) {
+	first := scenarioSpec{
+		ref: "RN500000006", status: "BOOKED", modelCode: "mx",
+		vin:     "7SAXCBE51RF123456",
+		options: "APBS,MDLX,PPSW,WX20,IBE00,ST0Y",
+		company: "Voltwerk Logistics B.V.",
+		window:  "Jun - Jul 2026", deliveryType: "DELIVERY_COMMERCIAL", center: "Rotterdam - Spaanse Polder",
+		appointment: "June 22, 2026 at 9:00 AM - Voltwerk Logistics B.V., Waalhaven 12, Rotterdam",
+		location:    "Rotterdam - Spaanse Polder", eta: "June 12, 2026",
+		reservationDate: "2026-03-03", bookedDate: "2026-03-05",
+		amountDue: 112490, currency: "EUR",
+		schedulingDone: true, insuranceDone: true,
+	}
+	second := first
+	second.ref = "RN500000007"
+	second.vin = ""
+	second.appointment = ""
+	second.location = ""
+	second.eta = ""
+	second.window = "Aug - Sep 2026"
+	second.schedulingDone = false
+
+	previous := first
+	previous.appointment = ""
+	previous.schedulingDone = false
+
+	diffs := map[string][]model.OrderDiff{
+		first.ref: {
+			{Field: "Delivery Appointment", OldValue: "N/A", NewValue: first.appointment},
+		},
+	}
+	history := map[string]*model.OrderHistory{
+		first.ref:  scenarioHistory(previous, first),
+		second.ref: scenarioHistory(second),
+	}
+	return []model.CombinedOrder{first.build(), second.build()}, diffs, history, nil
+}
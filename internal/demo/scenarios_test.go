@@ -0,0 +1,104 @@
+package demo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestGetDemoScenario_All(t *testing.T) {
+	for _, name := range Scenarios {
+		t.Run(name, func(t *testing.T) {
+			orders, diffs, history, err := GetDemoScenario(name)
+			if err != nil {
+				t.Fatalf("GetDemoScenario(%q) error = %v", name, err)
+			}
+			if len(orders) == 0 {
+				t.Fatal("expected at least one order")
+			}
+			for _, order := range orders {
+				ref := order.Order.ReferenceNumber
+				if order.Details.Tasks.Scheduling == nil || order.Details.Tasks.FinalPayment == nil {
+					t.Errorf("%s: typed tasks should be set", ref)
+				}
+				if len(order.Details.Tasks.Raw) == 0 {
+					t.Errorf("%s: raw tasks should be set", ref)
+				}
+				if _, ok := order.Details.RawJSON["tasks"]; !ok {
+					t.Errorf("%s: RawJSON should contain tasks", ref)
+				}
+				if h := history[ref]; h == nil || len(h.Snapshots) == 0 {
+					t.Errorf("%s: expected history", ref)
+				}
+			}
+			if len(diffs) == 0 {
+				t.Error("expected diffs")
+			}
+		})
+	}
+}
+
+func TestGetDemoScenario_Default(t *testing.T) {
+	orders, _, _, err := GetDemoScenario("")
+	if err != nil {
+		t.Fatalf("GetDemoScenario(\"\") error = %v", err)
+	}
+	if got, want := orders[0].Order.ReferenceNumber, GetDemoOrders()[0].Order.ReferenceNumber; got != want {
+		t.Errorf("default scenario order = %s, want %s", got, want)
+	}
+}
+
+func TestGetDemoScenario_Distinct(t *testing.T) {
+	models := make(map[string]string)
+	for _, name := range Scenarios {
+		orders, _, _, _ := GetDemoScenario(name)
+		code := orders[0].Order.ModelCode
+		if other, ok := models[code]; ok {
+			t.Errorf("scenarios %s and %s share model code %s", other, name, code)
+		}
+		models[code] = name
+	}
+}
+
+func TestGetDemoScenario_Characteristics(t *testing.T) {
+	orders, _, _, _ := GetDemoScenario("cybertruck")
+	if orders[0].Order.VIN != nil {
+		t.Error("cybertruck: VIN should not be assigned yet")
+	}
+	if _, currency, _ := orders[0].GetPaymentStatus(); currency != "USD" {
+		t.Errorf("cybertruck: currency = %s, want USD", currency)
+	}
+
+	orders, _, _, _ = GetDemoScenario("model-s-delivered")
+	for name, data := range orders[0].Details.Tasks.Raw {
+		var task model.TeslaTask
+		if err := json.Unmarshal(data, &task); err != nil {
+			t.Fatalf("model-s-delivered: task %s: %v", name, err)
+		}
+		if !task.Complete {
+			t.Errorf("model-s-delivered: task %s should be complete", name)
+		}
+	}
+	if amount, _, _ := orders[0].GetPaymentStatus(); amount != 0 {
+		t.Errorf("model-s-delivered: amount due = %d, want 0", amount)
+	}
+
+	orders, _, _, _ = GetDemoScenario("model-x-b2b")
+	for _, order := range orders {
+		if !order.Order.IsB2B || order.Order.OwnerCompanyName == nil {
+			t.Errorf("model-x-b2b: %s should be a B2B order", order.Order.ReferenceNumber)
+		}
+	}
+}
+
+func TestGetDemoScenario_Unknown(t *testing.T) {
+	_, _, _, err := GetDemoScenario("roadster")
+	if err == nil {
+		t.Fatal("expected error for unknown scenario")
+	}
+	if !strings.Contains(err.Error(), "model-x-b2b") {
+		t.Errorf("error should list available scenarios, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// deliveryDuration is the length of the calendar event for a delivery appointment
+const deliveryDuration = time.Hour
+
+// icsTimeLayout is the UTC date-time format used in iCalendar files
+const icsTimeLayout = "20060102T150405Z"
+
+// ErrNoAppointment is returned when an order has no parsable delivery appointment
+var ErrNoAppointment = errors.New("no delivery appointment scheduled")
+
+// now is swapped out in tests to get stable timestamps
+var now = time.Now
+
+// GenerateICS renders the delivery appointment of an order as an iCalendar
+// event. The appointment time is interpreted in loc (UTC when nil).
+func GenerateICS(order model.CombinedOrder, loc *time.Location) (string, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	appt := order.GetParsedAppointment()
+	start, ok := model.ParseAppointmentTimeIn(appt, loc)
+	if !ok {
+		return "", ErrNoAppointment
+	}
+	ref := order.Order.ReferenceNumber
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//tesla-delivery-tui//EN",
+		"BEGIN:VEVENT",
+		"UID:" + ref + "-delivery@tesla-delivery-tui",
+		"DTSTAMP:" + now().UTC().Format(icsTimeLayout),
+		"DTSTART:" + start.UTC().Format(icsTimeLayout),
+		"DTEND:" + start.Add(deliveryDuration).UTC().Format(icsTimeLayout),
+		"SUMMARY:" + escapeICS(fmt.Sprintf("Tesla %s delivery", order.Order.GetModelName())),
+	}
+	if appt.Address != "" {
+		lines = append(lines, "LOCATION:"+escapeICS(appt.Address))
+	}
+	lines = append(lines,
+		"DESCRIPTION:"+escapeICS(fmt.Sprintf("Order %s\nVIN: %s", ref, order.Order.GetVIN())),
+		"END:VEVENT",
+		"END:VCALENDAR",
+	)
+
+	return strings.Join(lines, "\r\n") + "\r\n", nil
+}
+
+// escapeICS escapes text values as required by RFC 5545
+func escapeICS(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+	).Replace(s)
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func calendarTestOrder(appointment string) model.CombinedOrder {
+	return model.CombinedOrder{
+		Order: model.TeslaOrder{ReferenceNumber: "RN123456789", ModelCode: "my"},
+		Details: model.OrderDetails{
+			Tasks: model.OrderTasks{
+				Scheduling: &model.SchedulingTask{ApptDateTimeAddressStr: appointment},
+			},
+		},
+	}
+}
+
+func TestGenerateICS(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC) }
+	defer func() { now = origNow }()
+
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	order := calendarTestOrder("June 15, 2026 at 10:00 AM - Tesla Delivery Center, Amsterdam")
+	ics, err := GenerateICS(order, amsterdam)
+	if err != nil {
+		t.Fatalf("GenerateICS() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"BEGIN:VEVENT\r\n",
+		"UID:RN123456789-delivery@tesla-delivery-tui\r\n",
+		"DTSTAMP:20260601T080000Z\r\n",
+		"DTSTART:20260615T080000Z\r\n",
+		"DTEND:20260615T090000Z\r\n",
+		"LOCATION:Tesla Delivery Center\\, Amsterdam\r\n",
+		"DESCRIPTION:Order RN123456789\\nVIN: N/A\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("GenerateICS() missing %q in:\n%s", want, ics)
+		}
+	}
+}
+
+func TestGenerateICS_NoAppointment(t *testing.T) {
+	_, err := GenerateICS(calendarTestOrder(""), nil)
+	if !errors.Is(err, ErrNoAppointment) {
+		t.Errorf("GenerateICS() error = %v, want ErrNoAppointment", err)
+	}
+}
+
+func TestEscapeICS(t *testing.T) {
+	got := escapeICS("a,b;c\\d\ne")
+	want := `a\,b\;c\\d\ne`
+	if got != want {
+		t.Errorf("escapeICS() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+// ChecklistFileName returns the file name used when exporting a checklist
+func ChecklistFileName(referenceNumber string) string {
+	return referenceNumber + "-checklist.txt"
+}
+
+// ExportChecklist renders the delivery checklist for an order as a plain-text report
+func ExportChecklist(order model.CombinedOrder, state *storage.ChecklistState) string {
+	checked := map[string]bool{}
+	if state != nil && state.Checked != nil {
+		checked = state.Checked
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Tesla Delivery Checklist – %s\n", order.Order.ReferenceNumber)
+
+	sections := storage.ChecklistFor(order.Order.ModelCode)
+	for _, section := range sections {
+		fmt.Fprintf(&b, "\nSection: %s\n", section.Title)
+		for _, item := range section.Items {
+			mark := " "
+			if checked[item.ID] {
+				mark = "x"
+			}
+			fmt.Fprintf(&b, "[%s] %s\n", mark, item.Text)
+		}
+	}
+
+	completed, total := storage.CountCompletedIn(sections, checked)
+	fmt.Fprintf(&b, "\nCompleted: %d/%d\n", completed, total)
+
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+func TestExportChecklist_Header(t *testing.T) {
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN123456789"}}
+	out := ExportChecklist(order, &storage.ChecklistState{ReferenceNumber: "RN123456789"})
+
+	want := "Tesla Delivery Checklist – RN123456789\n\n"
+	if !strings.HasPrefix(out, want) {
+		t.Errorf("ExportChecklist() should start with %q, got %q", want, out[:len(want)])
+	}
+}
+
+func TestExportChecklist_Sections(t *testing.T) {
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN123"}}
+	out := ExportChecklist(order, &storage.ChecklistState{ReferenceNumber: "RN123"})
+
+	for _, section := range storage.DeliveryChecklist {
+		if !strings.Contains(out, "Section: "+section.Title+"\n") {
+			t.Errorf("ExportChecklist() missing section %q", section.Title)
+		}
+		for _, item := range section.Items {
+			if !strings.Contains(out, item.Text) {
+				t.Errorf("ExportChecklist() missing item %q", item.Text)
+			}
+		}
+	}
+}
+
+func TestExportChecklist_CheckedAndUnchecked(t *testing.T) {
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN123"}}
+	first := storage.DeliveryChecklist[0].Items[0]
+	second := storage.DeliveryChecklist[0].Items[1]
+
+	state := &storage.ChecklistState{
+		ReferenceNumber: "RN123",
+		Checked:         map[string]bool{first.ID: true},
+	}
+	out := ExportChecklist(order, state)
+
+	if !strings.Contains(out, "[x] "+first.Text+"\n") {
+		t.Errorf("checked item %q should render as [x]", first.ID)
+	}
+	if !strings.Contains(out, "[ ] "+second.Text+"\n") {
+		t.Errorf("unchecked item %q should render as [ ]", second.ID)
+	}
+
+	_, total := storage.CountCompleted(nil)
+	if !strings.HasSuffix(out, fmt.Sprintf("Completed: 1/%d\n", total)) {
+		t.Errorf("ExportChecklist() missing completion summary, got %q", out)
+	}
+}
+
+func TestExportChecklist_NilState(t *testing.T) {
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN123"}}
+	out := ExportChecklist(order, nil)
+
+	if strings.Contains(out, "[x]") {
+		t.Error("nil state should render every item unchecked")
+	}
+}
+
+func TestChecklistFileName(t *testing.T) {
+	if got := ChecklistFileName("RN123"); got != "RN123-checklist.txt" {
+		t.Errorf("ChecklistFileName() = %q, want %q", got, "RN123-checklist.txt")
+	}
+}
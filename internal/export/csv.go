@@ -0,0 +1,47 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// HistoryCSVFileName returns the file name used when exporting an order's
+// history as CSV
+func HistoryCSVFileName(referenceNumber string) string {
+	return referenceNumber + "-history.csv"
+}
+
+// ExportHistoryCSV renders the changes between consecutive history snapshots
+// as CSV with the columns timestamp, field, old_value and new_value, one row
+// per changed field. Timestamps are RFC 3339.
+func ExportHistoryCSV(history *model.OrderHistory) (string, error) {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+
+	if err := w.Write([]string{"timestamp", "field", "old_value", "new_value"}); err != nil {
+		return "", err
+	}
+
+	if history != nil {
+		for i := 1; i < len(history.Snapshots); i++ {
+			snapshot := history.Snapshots[i]
+			timestamp := snapshot.Timestamp.Format(time.RFC3339)
+			for _, diff := range model.CompareOrders(history.Snapshots[i-1].Data, snapshot.Data) {
+				record := []string{timestamp, diff.Field, fmt.Sprint(diff.OldValue), fmt.Sprint(diff.NewValue)}
+				if err := w.Write(record); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
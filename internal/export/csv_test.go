@@ -0,0 +1,76 @@
+package export
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestHistoryCSVFileName(t *testing.T) {
+	if got, want := HistoryCSVFileName("RN123456789"), "RN123456789-history.csv"; got != want {
+		t.Errorf("HistoryCSVFileName() = %q, want %q", got, want)
+	}
+}
+
+func TestExportHistoryCSV(t *testing.T) {
+	first := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 5, 3, 14, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	history := &model.OrderHistory{
+		ReferenceNumber: "RN123456789",
+		Snapshots: []model.HistoricalSnapshot{
+			{Timestamp: first, Data: model.CombinedOrder{Order: model.TeslaOrder{OrderStatus: "BOOKED"}}},
+			{Timestamp: second, Data: model.CombinedOrder{
+				Order: model.TeslaOrder{OrderStatus: "IN_TRANSIT"},
+				Details: model.OrderDetails{Tasks: model.OrderTasks{Scheduling: &model.SchedulingTask{
+					DeliveryWindowDisplay: "May 10, 2026 - May 20, 2026",
+				}}},
+			}},
+		},
+	}
+
+	data, err := ExportHistoryCSV(history)
+	if err != nil {
+		t.Fatalf("ExportHistoryCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("exported CSV does not parse: %v", err)
+	}
+
+	want := [][]string{
+		{"timestamp", "field", "old_value", "new_value"},
+		{"2026-05-03T14:30:00+02:00", "Order Status", "BOOKED", "IN_TRANSIT"},
+		{"2026-05-03T14:30:00+02:00", "Delivery Window", "N/A", "May 10, 2026 - May 20, 2026"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("CSV has %d rows, want %d:\n%s", len(records), len(want), data)
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("row %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+
+	if _, err := time.Parse(time.RFC3339, records[1][0]); err != nil {
+		t.Errorf("timestamp %q is not RFC 3339: %v", records[1][0], err)
+	}
+	if !strings.Contains(data, `"May 10, 2026 - May 20, 2026"`) {
+		t.Errorf("values with commas should be quoted, got:\n%s", data)
+	}
+}
+
+func TestExportHistoryCSV_NoChanges(t *testing.T) {
+	for _, history := range []*model.OrderHistory{nil, {ReferenceNumber: "RN123456789"}} {
+		data, err := ExportHistoryCSV(history)
+		if err != nil {
+			t.Fatalf("ExportHistoryCSV() error = %v", err)
+		}
+		if data != "timestamp,field,old_value,new_value\n" {
+			t.Errorf("ExportHistoryCSV() = %q, want only the header row", data)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// Version is recorded in exported files; main sets it to the build version
+var Version = "dev"
+
+// OrdersExport is the document written when exporting all orders
+type OrdersExport struct {
+	ExportedAt string                `json:"exported_at"`
+	Version    string                `json:"version"`
+	Orders     []model.CombinedOrder `json:"orders"`
+}
+
+// OrdersFileName returns the file name used when exporting all orders,
+// dated with the current day
+func OrdersFileName() string {
+	return fmt.Sprintf("orders-%s.json", now().Format("2006-01-02"))
+}
+
+// ExportOrders renders orders as an indented JSON document with export
+// metadata
+func ExportOrders(orders []model.CombinedOrder) (string, error) {
+	if orders == nil {
+		orders = []model.CombinedOrder{}
+	}
+	data, err := json.MarshalIndent(OrdersExport{
+		ExportedAt: now().UTC().Format(time.RFC3339),
+		Version:    Version,
+		Orders:     orders,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal orders: %w", err)
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestExportOrders(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC) }
+	defer func() { now = origNow }()
+
+	vin := "XP7YACEF5TB123456"
+	orders := []model.CombinedOrder{
+		{Order: model.TeslaOrder{ReferenceNumber: "RN100000001", ModelCode: "my", VIN: &vin}},
+		{Order: model.TeslaOrder{ReferenceNumber: "RN100000002", ModelCode: "m3"}},
+	}
+
+	out, err := ExportOrders(orders)
+	if err != nil {
+		t.Fatalf("ExportOrders() error = %v", err)
+	}
+
+	var got OrdersExport
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("exported JSON does not parse: %v", err)
+	}
+	if got.ExportedAt != "2026-06-01T08:00:00Z" {
+		t.Errorf("ExportedAt = %q, want %q", got.ExportedAt, "2026-06-01T08:00:00Z")
+	}
+	if got.Version != Version {
+		t.Errorf("Version = %q, want %q", got.Version, Version)
+	}
+	if len(got.Orders) != len(orders) {
+		t.Fatalf("exported %d orders, want %d", len(got.Orders), len(orders))
+	}
+	for i, order := range got.Orders {
+		if order.Order.ReferenceNumber != orders[i].Order.ReferenceNumber {
+			t.Errorf("order %d ReferenceNumber = %s, want %s", i, order.Order.ReferenceNumber, orders[i].Order.ReferenceNumber)
+		}
+	}
+	if got.Orders[0].Order.GetVIN() != vin {
+		t.Errorf("VIN = %s, want %s", got.Orders[0].Order.GetVIN(), vin)
+	}
+}
+
+func TestExportOrders_Empty(t *testing.T) {
+	out, err := ExportOrders(nil)
+	if err != nil {
+		t.Fatalf("ExportOrders() error = %v", err)
+	}
+	var got OrdersExport
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("exported JSON does not parse: %v", err)
+	}
+	if got.Orders == nil || len(got.Orders) != 0 {
+		t.Errorf("Orders = %v, want empty array", got.Orders)
+	}
+}
+
+func TestOrdersFileName(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC) }
+	defer func() { now = origNow }()
+
+	if got, want := OrdersFileName(), "orders-2026-06-01.json"; got != want {
+		t.Errorf("OrdersFileName() = %q, want %q", got, want)
+	}
+}
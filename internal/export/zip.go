@@ -0,0 +1,55 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+// ChecklistsZipFileName returns the file name used when exporting every
+// checklist, dated with the current day
+func ChecklistsZipFileName() string {
+	return fmt.Sprintf("tesla-checklists-%s.zip", now().Format("2006-01-02"))
+}
+
+// ExportAllChecklists bundles the checklist states stored under baseDir for
+// the given reference numbers into a ZIP archive, one <ref>.json per order
+func ExportAllChecklists(baseDir string, refs []string) ([]byte, error) {
+	checklist, err := storage.NewChecklist(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, ref := range refs {
+		state, err := checklist.LoadState(ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load checklist for %s: %w", ref, err)
+		}
+		data, err := json.MarshalIndent(state, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal checklist for %s: %w", ref, err)
+		}
+
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     ref + ".json",
+			Method:   zip.Deflate,
+			Modified: now(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to archive: %w", ref, err)
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, fmt.Errorf("failed to add %s to archive: %w", ref, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,110 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+func TestChecklistsZipFileName(t *testing.T) {
+	origNow := now
+	now = func() time.Time { return time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC) }
+	defer func() { now = origNow }()
+
+	if got, want := ChecklistsZipFileName(), "tesla-checklists-2026-06-01.zip"; got != want {
+		t.Errorf("ChecklistsZipFileName() = %q, want %q", got, want)
+	}
+}
+
+func TestExportAllChecklists(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	checklist, err := storage.NewChecklist(tempDir)
+	if err != nil {
+		t.Fatalf("NewChecklist() error = %v", err)
+	}
+	if _, err := checklist.ToggleItem("RN100000001", "insured"); err != nil {
+		t.Fatalf("ToggleItem() error = %v", err)
+	}
+
+	// RN100000002 has no saved state and is exported empty
+	refs := []string{"RN100000001", "RN100000002"}
+	data, err := ExportAllChecklists(tempDir, refs)
+	if err != nil {
+		t.Fatalf("ExportAllChecklists() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("archive does not open: %v", err)
+	}
+	if len(zr.File) != len(refs) {
+		t.Fatalf("archive has %d files, want %d", len(zr.File), len(refs))
+	}
+
+	var names []string
+	states := map[string]storage.ChecklistState{}
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open(%s) error = %v", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("ReadAll(%s) error = %v", f.Name, err)
+		}
+		var state storage.ChecklistState
+		if err := json.Unmarshal(content, &state); err != nil {
+			t.Fatalf("%s does not parse: %v", f.Name, err)
+		}
+		states[f.Name] = state
+	}
+
+	sort.Strings(names)
+	want := []string{"RN100000001.json", "RN100000002.json"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("file %d = %q, want %q", i, names[i], want[i])
+		}
+	}
+
+	if !states["RN100000001.json"].Checked["insured"] {
+		t.Error("RN100000001.json should have the checked item")
+	}
+	if len(states["RN100000002.json"].Checked) != 0 {
+		t.Error("RN100000002.json should have no checked items")
+	}
+}
+
+func TestExportAllChecklists_NoOrders(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-delivery-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	data, err := ExportAllChecklists(tempDir, nil)
+	if err != nil {
+		t.Fatalf("ExportAllChecklists() error = %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("archive does not open: %v", err)
+	}
+	if len(zr.File) != 0 {
+		t.Errorf("archive has %d files, want 0", len(zr.File))
+	}
+}
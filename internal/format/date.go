@@ -0,0 +1,33 @@
+// Package format renders values for display according to user preferences
+package format
+
+import "time"
+
+// Date formats selectable in the settings
+const (
+	DateLongUS = "long-us" // January 2, 2006
+	DateISO    = "iso"     // 2006-01-02
+	DateEU     = "eu"      // 02.01.2006
+	DateShort  = "short"   // Jan 2, 2006
+)
+
+// DateFormats lists the valid date formats, starting with the default
+var DateFormats = []string{DateLongUS, DateISO, DateEU, DateShort}
+
+// dateLayouts maps each date format to its time layout
+var dateLayouts = map[string]string{
+	DateLongUS: "January 2, 2006",
+	DateISO:    "2006-01-02",
+	DateEU:     "02.01.2006",
+	DateShort:  "Jan 2, 2006",
+}
+
+// FormatDate formats the date part of t in the given date format, falling
+// back to DateLongUS for unknown formats
+func FormatDate(t time.Time, format string) string {
+	layout, ok := dateLayouts[format]
+	if !ok {
+		layout = dateLayouts[DateLongUS]
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,41 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2025, time.March, 7, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{DateLongUS, "March 7, 2025"},
+		{DateISO, "2025-03-07"},
+		{DateEU, "07.03.2025"},
+		{DateShort, "Mar 7, 2025"},
+		{"", "March 7, 2025"},
+		{"unknown", "March 7, 2025"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := FormatDate(date, tt.format); got != tt.want {
+				t.Errorf("FormatDate(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFormats_AllHaveLayouts(t *testing.T) {
+	if DateFormats[0] != DateLongUS {
+		t.Errorf("DateFormats[0] = %q, want the default %q", DateFormats[0], DateLongUS)
+	}
+	for _, f := range DateFormats {
+		if _, ok := dateLayouts[f]; !ok {
+			t.Errorf("date format %q has no layout", f)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import "strings"
+
+// Limits for the vehicle silhouettes, so they fit beside the status badges
+const (
+	ASCIIArtMaxLines = 5
+	ASCIIArtMaxWidth = 30
+)
+
+// Vehicle silhouettes, keyed by model name. Model S and 3 share the sedan
+// shape, Model X and Y the SUV shape.
+var (
+	sedanArt = strings.Join([]string{
+		`        ___________`,
+		`   ____/    |      \___`,
+		`  /  _      |     _    \`,
+		`  '-(_)-----------(_)--'`,
+	}, "\n")
+
+	suvArt = strings.Join([]string{
+		`      ______________`,
+		`   __/   |    |     \__`,
+		`  |  _   |    |   _    \`,
+		`  '-(_)-----------(_)--'`,
+	}, "\n")
+
+	truckArt = strings.Join([]string{
+		`          __..--''\`,
+		`   __..--''         \__`,
+		`  |  _             _   |`,
+		`  '-(_)-----------(_)--'`,
+	}, "\n")
+
+	asciiArt = map[string]string{
+		"Model S":    sedanArt,
+		"Model 3":    sedanArt,
+		"Model X":    suvArt,
+		"Model Y":    suvArt,
+		"Cybertruck": truckArt,
+	}
+)
+
+// GetASCIIArt returns a small ASCII silhouette of the vehicle for a model
+// code, or "" when there is none for the model
+func GetASCIIArt(modelCode string) string {
+	order := TeslaOrder{ModelCode: modelCode}
+	return asciiArt[order.GetModelName()]
+}
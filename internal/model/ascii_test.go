@@ -0,0 +1,39 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestGetASCIIArt(t *testing.T) {
+	for _, code := range []string{"ms", "m3", "mx", "my", "ct"} {
+		t.Run(code, func(t *testing.T) {
+			art := GetASCIIArt(code)
+			if strings.TrimSpace(art) == "" {
+				t.Fatalf("GetASCIIArt(%q) is empty", code)
+			}
+			lines := strings.Split(art, "\n")
+			if len(lines) > ASCIIArtMaxLines {
+				t.Errorf("GetASCIIArt(%q) has %d lines, want at most %d", code, len(lines), ASCIIArtMaxLines)
+			}
+			for _, line := range lines {
+				if width := utf8.RuneCountInString(line); width > ASCIIArtMaxWidth {
+					t.Errorf("GetASCIIArt(%q) line %q is %d columns, want at most %d", code, line, width, ASCIIArtMaxWidth)
+				}
+			}
+		})
+	}
+}
+
+func TestGetASCIIArt_Shapes(t *testing.T) {
+	if GetASCIIArt("my") == GetASCIIArt("m3") {
+		t.Error("Model Y and Model 3 should have different silhouettes")
+	}
+	if GetASCIIArt("ct") == GetASCIIArt("my") {
+		t.Error("Cybertruck and Model Y should have different silhouettes")
+	}
+	if got := GetASCIIArt("unknown"); got != "" {
+		t.Errorf("GetASCIIArt(unknown) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+// ChecklistItem represents a single checklist item
+type ChecklistItem struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// ChecklistSection represents a group of checklist items
+type ChecklistSection struct {
+	Title string          `json:"title"`
+	Items []ChecklistItem `json:"items"`
+}
+
+// modelChecklists holds the extra delivery checks for models with their own
+// quirks, keyed by model name
+var modelChecklists = map[string][]ChecklistSection{
+	"Model X": {
+		{
+			Title: "Model X Falcon Wing Doors",
+			Items: []ChecklistItem{
+				{ID: "mx_falcon_open_close", Text: "Falcon wing doors open and close smoothly from the key, app and door buttons"},
+				{ID: "mx_falcon_sensors", Text: "Falcon wing door ceiling and side sensors stop the doors near obstacles"},
+				{ID: "mx_falcon_seals", Text: "Falcon wing door seals seated with no gaps along the roof line"},
+				{ID: "mx_front_doors", Text: "Auto-presenting front doors open and close on approach"},
+			},
+		},
+	},
+	"Cybertruck": {
+		{
+			Title: "Cybertruck",
+			Items: []ChecklistItem{
+				{ID: "ct_vault_cover", Text: "Vault cover (tonneau) opens and closes fully without binding"},
+				{ID: "ct_vault_drain", Text: "Vault bed drains clear and bed liner is free of damage"},
+				{ID: "ct_tailgate", Text: "Powered tailgate opens, closes and locks"},
+				{ID: "ct_air_suspension", Text: "Air suspension raises and lowers through all ride heights"},
+				{ID: "ct_outlets", Text: "Bed and cabin power outlets supply power"},
+				{ID: "ct_stainless_panels", Text: "Stainless steel panels free of dents and fingerprint etching"},
+			},
+		},
+	},
+}
+
+// ModelSpecificChecklist returns the extra checklist sections for a model
+// code, to be appended to the standard delivery checklist, or nil when the
+// model has none
+func ModelSpecificChecklist(modelCode string) []ChecklistSection {
+	order := TeslaOrder{ModelCode: modelCode}
+	return modelChecklists[order.GetModelName()]
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestModelSpecificChecklist(t *testing.T) {
+	tests := []struct {
+		modelCode  string
+		wantPrefix string
+	}{
+		{"mx", "mx_"},
+		{"MX", "mx_"},
+		{"ct", "ct_"},
+		{"cybertruck", "ct_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelCode, func(t *testing.T) {
+			sections := ModelSpecificChecklist(tt.modelCode)
+			if len(sections) == 0 {
+				t.Fatalf("ModelSpecificChecklist(%q) returned no sections", tt.modelCode)
+			}
+			for _, section := range sections {
+				if len(section.Items) == 0 {
+					t.Errorf("section %q has no items", section.Title)
+				}
+				for _, item := range section.Items {
+					if !strings.HasPrefix(item.ID, tt.wantPrefix) {
+						t.Errorf("item ID %q should start with %q", item.ID, tt.wantPrefix)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestModelSpecificChecklist_NoExtras(t *testing.T) {
+	for _, code := range []string{"my", "m3", "ms", "", "unknown"} {
+		if sections := ModelSpecificChecklist(code); sections != nil {
+			t.Errorf("ModelSpecificChecklist(%q) = %v, want nil", code, sections)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"sort"
+	"strings"
+)
+
+// Limits for matching unknown option codes to known ones. Only codes one
+// edit away that share the known code's prefix family (its first two
+// characters, such as PP for paints or MT for trims) are considered.
+const (
+	maxFuzzyEdits       = 1
+	fuzzyPrefixLength   = 2
+	fuzzyMatchThreshold = 0.8 // minimum confidence to show a fuzzy match
+)
+
+// knownOptionCodes lists every decodable option code in sorted order, so
+// ties between equally close codes are broken the same way every time
+var knownOptionCodes = sortedOptionCodes()
+
+// sortedOptionCodes returns the codes of TeslaOptionCodes in sorted order
+func sortedOptionCodes() []string {
+	codes := make([]string, 0, len(TeslaOptionCodes))
+	for code := range TeslaOptionCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// LevenshteinDistance returns the number of single-character insertions,
+// deletions and substitutions needed to turn a into b
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// FuzzyDecodeOptionCode returns the description of the known option code
+// in the same prefix family closest to code, within one edit. Confidence is
+// 1 for an exact match and drops with each edit relative to the length of
+// both codes; it is 0 when no code is close enough.
+func FuzzyDecodeOptionCode(code string) (description string, confidence float64) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", 0
+	}
+	if desc := DecodeOptionCode(code); desc != "" {
+		return desc, 1
+	}
+
+	prefix := optionPrefix(code)
+	best, bestDistance := "", maxFuzzyEdits+1
+	for _, known := range knownOptionCodes {
+		if optionPrefix(known) != prefix {
+			continue
+		}
+		if d := LevenshteinDistance(code, known); d < bestDistance {
+			best, bestDistance = known, d
+		}
+	}
+	if best == "" {
+		return "", 0
+	}
+
+	total := len([]rune(code)) + len([]rune(best))
+	return DecodeOptionCode(best), 1 - float64(bestDistance)/float64(total)
+}
+
+// optionPrefix returns the prefix family of an option code
+func optionPrefix(code string) string {
+	runes := []rune(code)
+	if len(runes) < fuzzyPrefixLength {
+		return ""
+	}
+	return string(runes[:fuzzyPrefixLength])
+}
@@ -0,0 +1,94 @@
+package model
+
+import "testing"
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"PPSW", "PPSW", 0},
+		{"PPSW", "PPSX", 1},
+		{"PPSW", "PPS", 1},
+		{"PPSW", "PPSWW", 1},
+		{"PPSW", "", 4},
+		{"kitten", "sitting", 3},
+		{"WY19B", "WY21B", 2},
+	}
+	for _, tt := range tests {
+		if got := LevenshteinDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := LevenshteinDistance(tt.b, tt.a); got != tt.want {
+			t.Errorf("LevenshteinDistance(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFuzzyDecodeOptionCode(t *testing.T) {
+	t.Run("exact match", func(t *testing.T) {
+		desc, confidence := FuzzyDecodeOptionCode("PPSW")
+		if desc != "Pearl White Multi-Coat" || confidence != 1 {
+			t.Errorf("FuzzyDecodeOptionCode(PPSW) = %q, %v", desc, confidence)
+		}
+	})
+
+	t.Run("one edit", func(t *testing.T) {
+		// PPSW with an extra character: only one known code is that close
+		desc, confidence := FuzzyDecodeOptionCode("PPSW2")
+		if desc != "Pearl White Multi-Coat" {
+			t.Errorf("FuzzyDecodeOptionCode(PPSW2) = %q, want Pearl White Multi-Coat", desc)
+		}
+		if confidence <= fuzzyMatchThreshold || confidence >= 1 {
+			t.Errorf("confidence = %v, want between %v and 1", confidence, fuzzyMatchThreshold)
+		}
+	})
+
+	t.Run("two edits", func(t *testing.T) {
+		// APH4 with two letters swapped
+		if desc, confidence := FuzzyDecodeOptionCode("AHP4"); desc != "" || confidence != 0 {
+			t.Errorf("FuzzyDecodeOptionCode(AHP4) = %q, %v; want no match", desc, confidence)
+		}
+	})
+
+	t.Run("unrelated short codes", func(t *testing.T) {
+		for _, code := range []string{"ABCD", "XX01", "$MT3"} {
+			if desc, confidence := FuzzyDecodeOptionCode(code); desc != "" || confidence != 0 {
+				t.Errorf("FuzzyDecodeOptionCode(%s) = %q, %v; want no match", code, desc, confidence)
+			}
+		}
+	})
+
+	t.Run("other prefix family", func(t *testing.T) {
+		// One edit from PPSW, but the prefix is no longer a paint prefix
+		if desc, confidence := FuzzyDecodeOptionCode("XPSW"); desc != "" || confidence != 0 {
+			t.Errorf("FuzzyDecodeOptionCode(XPSW) = %q, %v; want no match", desc, confidence)
+		}
+	})
+
+	t.Run("very different code", func(t *testing.T) {
+		if desc, confidence := FuzzyDecodeOptionCode("QQQQQQQQ"); desc != "" || confidence != 0 {
+			t.Errorf("FuzzyDecodeOptionCode(QQQQQQQQ) = %q, %v; want no match", desc, confidence)
+		}
+	})
+
+	t.Run("empty code", func(t *testing.T) {
+		if desc, confidence := FuzzyDecodeOptionCode(""); desc != "" || confidence != 0 {
+			t.Errorf("FuzzyDecodeOptionCode(\"\") = %q, %v; want no match", desc, confidence)
+		}
+	})
+}
+
+func TestDecodeOptions_FuzzyMatch(t *testing.T) {
+	options := DecodeOptions("PPSW2,AHP4,QQQQQQQQ")
+	if got, want := options[0].Description, "~Pearl White Multi-Coat"; got != want {
+		t.Errorf("description of PPSW2 = %q, want %q", got, want)
+	}
+	if got := options[1].Description; got != "" {
+		t.Errorf("description of AHP4 = %q, want empty", got)
+	}
+	if got := options[2].Description; got != "" {
+		t.Errorf("description of QQQQQQQQ = %q, want empty", got)
+	}
+}
@@ -37,6 +37,7 @@ var TeslaOptionCodes = map[string]string{
 	"PMAB": "Quicksilver",
 	"PMSG": "Stealth Grey",
 	"PMMB": "Ultra Blue",
+	"PBST": "Stainless Steel (Cybertruck)",
 
 	// Interior
 	"IBB0": "All Black Interior",
@@ -79,6 +80,7 @@ var TeslaOptionCodes = map[string]string{
 	"MDLS": "Model S",
 	"MDLX": "Model X",
 	"MDLY": "Model Y",
+	"MDLC": "Cybertruck",
 	"REEU": "European Region",
 	"RENA": "North American Region",
 	"RENC": "Canadian Region",
@@ -102,6 +104,8 @@ var TeslaOptionCodes = map[string]string{
 	"WY19P": "19\" Sport Wheels",
 	"WY20P": "20\" Induction Wheels",
 	"WY21P": "21\" Überturbine Wheels",
+	"WC20A": "20\" All-Terrain Wheels",
+	"WC20C": "20\" Cyber Wheels with Aero Covers",
 
 	// Seats
 	"ST00": "Non-Performance Seats",
@@ -217,7 +221,9 @@ func DecodeOptionCode(code string) string {
 	return "" // Unknown code
 }
 
-// DecodeOptions takes a comma-separated string of option codes and returns decoded options
+// DecodeOptions takes a comma-separated string of option codes and returns
+// decoded options. Unknown codes close to a known one get its description
+// prefixed with "~".
 func DecodeOptions(optionsStr string) []DecodedOption {
 	if optionsStr == "" {
 		return nil
@@ -232,6 +238,12 @@ func DecodeOptions(optionsStr string) []DecodedOption {
 			continue
 		}
 		desc := DecodeOptionCode(code)
+		if desc == "" {
+			// Likely a variant of a known code; the tilde marks the guess
+			if guess, confidence := FuzzyDecodeOptionCode(code); confidence > fuzzyMatchThreshold {
+				desc = "~" + guess
+			}
+		}
 		options = append(options, DecodedOption{
 			Code:        code,
 			Description: desc,
@@ -274,6 +286,9 @@ func CategorizeOptions(options []DecodedOption) map[string][]DecodedOption {
 			categories["Autopilot"] = append(categories["Autopilot"], opt)
 		case strings.HasPrefix(code, "SC") || strings.HasPrefix(code, "CH"):
 			categories["Charging"] = append(categories["Charging"], opt)
+		case isPaintDescription(DecodeOptionCode(code)):
+			// Paints whose codes don't follow the usual prefixes
+			categories["Paint"] = append(categories["Paint"], opt)
 		default:
 			categories["Other"] = append(categories["Other"], opt)
 		}
@@ -281,3 +296,25 @@ func CategorizeOptions(options []DecodedOption) map[string][]DecodedOption {
 
 	return categories
 }
+
+// paintKeywords mark an option description as a paint, unless it also
+// has one of nonPaintKeywords (such as "Paint Armor Film")
+var (
+	paintKeywords    = []string{"Metallic", "Multi-Coat", "Coat", "Paint"}
+	nonPaintKeywords = []string{"Film", "Armor"}
+)
+
+// isPaintDescription reports whether an option description names a paint
+func isPaintDescription(desc string) bool {
+	for _, keyword := range nonPaintKeywords {
+		if strings.Contains(desc, keyword) {
+			return false
+		}
+	}
+	for _, keyword := range paintKeywords {
+		if strings.Contains(desc, keyword) {
+			return true
+		}
+	}
+	return false
+}
@@ -78,6 +78,12 @@ func TestDecodeOptions(t *testing.T) {
 			wantLen:    3,
 			wantFirst:  DecodedOption{Code: "PPSW", Description: "Pearl White Multi-Coat"},
 		},
+		{
+			name:       "cybertruck options",
+			optionsStr: "MDLC,PBST,WC20A",
+			wantLen:    3,
+			wantFirst:  DecodedOption{Code: "MDLC", Description: "Cybertruck"},
+		},
 		{
 			name:       "empty string",
 			optionsStr: "",
@@ -145,6 +151,26 @@ func TestCategorizeOptions(t *testing.T) {
 	}
 }
 
+func TestCategorizeOptions_Cybertruck(t *testing.T) {
+	options := DecodeOptions("MDLC,PBST,WC20C")
+	categories := CategorizeOptions(options)
+
+	if got := len(categories["Model"]); got != 1 {
+		t.Errorf("Model category has %d options, want 1 (MDLC)", got)
+	}
+	if got := len(categories["Paint"]); got != 1 {
+		t.Errorf("Paint category has %d options, want 1", got)
+	}
+	if got := len(categories["Wheels"]); got != 1 {
+		t.Errorf("Wheels category has %d options, want 1", got)
+	}
+	for _, opt := range options {
+		if opt.Description == "" {
+			t.Errorf("option %q has no description", opt.Code)
+		}
+	}
+}
+
 func TestCategorizeOptions_PaintPrefixes(t *testing.T) {
 	// Test all paint prefixes are properly categorized
 	paintCodes := []string{"PPSW", "PMTG", "PBSB", "PN00", "PR00"}
@@ -159,6 +185,58 @@ func TestCategorizeOptions_PaintPrefixes(t *testing.T) {
 	}
 }
 
+func TestCategorizeOptions_PaintByDescription(t *testing.T) {
+	// Add a special paint whose code doesn't use a paint prefix
+	TeslaOptionCodes["SPXX"] = "Special Edition Metallic"
+	defer delete(TeslaOptionCodes, "SPXX")
+
+	tests := []struct {
+		code     string
+		category string
+	}{
+		{"SPXX", "Paint"}, // unknown prefix, "Metallic" description
+		{"SPMR", "Paint"}, // "Red Multi-Coat" outside the paint prefixes
+		{"PAF0", "Other"}, // "No Paint Armor Film" is protection, not paint
+		{"PAF1", "Other"}, // "Paint Armor Film" is protection, not paint
+		{"PMAB", "Paint"}, // PM prefix
+		{"PMSG", "Paint"}, // PM prefix
+		{"PMMB", "Paint"}, // PM prefix
+		{"GLFR", "Other"}, // "Gloss Finish" is not a paint
+		{"MDLY", "Model"}, // prefixes are checked first
+		{"ZZZZ", "Other"}, // unknown code without description
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			categories := CategorizeOptions(DecodeOptions(tt.code))
+			if len(categories[tt.category]) != 1 {
+				t.Errorf("%s should be categorized as %s", tt.code, tt.category)
+			}
+		})
+	}
+}
+
+func TestIsPaintDescription(t *testing.T) {
+	tests := []struct {
+		desc string
+		want bool
+	}{
+		{"Midnight Silver Metallic", true},
+		{"Pearl White Multi-Coat", true},
+		{"Clear Coat", true},
+		{"Paint Armor Film", false},
+		{"No Paint Armor Film", false},
+		{"Solid Black", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isPaintDescription(tt.desc); got != tt.want {
+			t.Errorf("isPaintDescription(%q) = %v, want %v", tt.desc, got, tt.want)
+		}
+	}
+}
+
 func TestCategorizeOptions_EmptyInput(t *testing.T) {
 	categories := CategorizeOptions(nil)
 
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+// OrderStats holds aggregate metrics across all orders
+type OrderStats struct {
+	TotalOrders      int
+	ByStatus         map[string]int
+	EarliestDelivery *time.Time
+	TotalAmountDue   int64
+}
+
+// ComputeStats aggregates counts, the earliest delivery appointment and
+// the total amount due for the given orders
+func ComputeStats(orders []CombinedOrder) OrderStats {
+	stats := OrderStats{
+		TotalOrders: len(orders),
+		ByStatus:    make(map[string]int),
+	}
+
+	for i := range orders {
+		order := &orders[i]
+
+		status := order.Order.OrderStatus
+		if status == "" {
+			status = "UNKNOWN"
+		}
+		stats.ByStatus[status]++
+
+		if t, ok := ParseAppointmentTime(order.GetParsedAppointment()); ok {
+			if stats.EarliestDelivery == nil || t.Before(*stats.EarliestDelivery) {
+				stats.EarliestDelivery = &t
+			}
+		}
+
+		amount, _, _ := order.GetPaymentStatus()
+		stats.TotalAmountDue += amount
+	}
+
+	return stats
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func statsTestOrder(status, appointment, finalPayment string) CombinedOrder {
+	order := CombinedOrder{
+		Order: TeslaOrder{OrderStatus: status},
+	}
+	if appointment != "" {
+		order.Details.Tasks.Scheduling = &SchedulingTask{ApptDateTimeAddressStr: appointment}
+	}
+	if finalPayment != "" {
+		order.Details.Tasks.Raw = map[string]json.RawMessage{
+			"finalPayment": json.RawMessage(finalPayment),
+		}
+	}
+	return order
+}
+
+func TestComputeStats(t *testing.T) {
+	orders := []CombinedOrder{
+		statsTestOrder("BOOKED", "June 15, 2026 at 10:00 AM - Tesla Delivery Center Amsterdam", `{"amountDue": 39120}`),
+		statsTestOrder("BOOKED", "May 2, 2026 at 2:30 PM - Tesla Delivery Center Utrecht", `{"amountDue": 1500.75}`),
+		statsTestOrder("DELIVERED", "", `{"amountDue": 0}`),
+		statsTestOrder("", "TBD", `not json`),
+	}
+
+	stats := ComputeStats(orders)
+
+	if stats.TotalOrders != 4 {
+		t.Errorf("TotalOrders = %d, want 4", stats.TotalOrders)
+	}
+
+	wantStatus := map[string]int{"BOOKED": 2, "DELIVERED": 1, "UNKNOWN": 1}
+	if len(stats.ByStatus) != len(wantStatus) {
+		t.Errorf("ByStatus = %v, want %v", stats.ByStatus, wantStatus)
+	}
+	for status, want := range wantStatus {
+		if got := stats.ByStatus[status]; got != want {
+			t.Errorf("ByStatus[%q] = %d, want %d", status, got, want)
+		}
+	}
+
+	if stats.EarliestDelivery == nil {
+		t.Fatal("EarliestDelivery should be set")
+	}
+	want := time.Date(2026, time.May, 2, 14, 30, 0, 0, time.UTC)
+	if !stats.EarliestDelivery.Equal(want) {
+		t.Errorf("EarliestDelivery = %v, want %v", stats.EarliestDelivery, want)
+	}
+
+	if stats.TotalAmountDue != 40620 {
+		t.Errorf("TotalAmountDue = %d, want 40620", stats.TotalAmountDue)
+	}
+}
+
+func TestComputeStats_Empty(t *testing.T) {
+	stats := ComputeStats(nil)
+
+	if stats.TotalOrders != 0 {
+		t.Errorf("TotalOrders = %d, want 0", stats.TotalOrders)
+	}
+	if stats.ByStatus == nil || len(stats.ByStatus) != 0 {
+		t.Errorf("ByStatus = %v, want empty map", stats.ByStatus)
+	}
+	if stats.EarliestDelivery != nil {
+		t.Errorf("EarliestDelivery = %v, want nil", stats.EarliestDelivery)
+	}
+	if stats.TotalAmountDue != 0 {
+		t.Errorf("TotalAmountDue = %d, want 0", stats.TotalAmountDue)
+	}
+}
+
+func TestParseAppointmentTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		appt   *AppointmentDetails
+		want   time.Time
+		wantOK bool
+	}{
+		{"nil", nil, time.Time{}, false},
+		{"date and time", &AppointmentDetails{Date: "June 15, 2026", Time: "10:00 AM"}, time.Date(2026, 6, 15, 10, 0, 0, 0, time.UTC), true},
+		{"date only", &AppointmentDetails{Date: "Jun 15, 2026"}, time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC), true},
+		{"iso date", &AppointmentDetails{Date: "2026-06-15"}, time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC), true},
+		{"unparseable", &AppointmentDetails{Date: "TBD"}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseAppointmentTime(tt.appt)
+			if ok != tt.wantOK {
+				t.Fatalf("ParseAppointmentTime() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseAppointmentTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAppointmentTimeIn(t *testing.T) {
+	appt := &AppointmentDetails{Date: "June 15, 2026", Time: "10:00 AM"}
+
+	tests := []struct {
+		zone    string
+		wantUTC time.Time
+	}{
+		{"US/Eastern", time.Date(2026, 6, 15, 14, 0, 0, 0, time.UTC)},
+		{"Europe/Amsterdam", time.Date(2026, 6, 15, 8, 0, 0, 0, time.UTC)},
+		{"Asia/Shanghai", time.Date(2026, 6, 15, 2, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Skipf("timezone data unavailable: %v", err)
+			}
+			got, ok := ParseAppointmentTimeIn(appt, loc)
+			if !ok {
+				t.Fatal("ParseAppointmentTimeIn() ok = false")
+			}
+			if !got.Equal(tt.wantUTC) {
+				t.Errorf("ParseAppointmentTimeIn() = %v, want %v", got.UTC(), tt.wantUTC)
+			}
+		})
+	}
+}
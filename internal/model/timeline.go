@@ -0,0 +1,112 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Timeline stages that are timed from history snapshots
+const (
+	StageVINAssigned      = "VIN Assigned"
+	StageInTransit        = "In Transit"
+	StageReadyForDelivery = "Ready for Delivery"
+)
+
+// StageConfigure is reached once the order has been configured, before a
+// VIN is assigned
+const StageConfigure = "Configure"
+
+// StageDelivered is the final timeline stage
+const StageDelivered = "Delivered"
+
+// bookedDateLayouts are the formats seen in orderBookedDate
+var bookedDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// OrderTimeline holds how long after the order was booked each timeline
+// stage was first reached
+type OrderTimeline struct {
+	StageTimings map[string]time.Duration
+}
+
+// ComputeTimeline derives stage timings from the order booked date and the
+// first history snapshot in which each stage was reached. Stages already
+// reached in the oldest snapshot are skipped, since they happened before
+// tracking started and their timing is unknown.
+func ComputeTimeline(order CombinedOrder, history *OrderHistory) OrderTimeline {
+	timeline := OrderTimeline{StageTimings: make(map[string]time.Duration)}
+
+	booked, ok := parseBookedDate(order.GetOrderBookedDate())
+	if !ok {
+		return timeline
+	}
+
+	for stage, reachedAt := range GetStageTimestamps(history) {
+		if d := reachedAt.Sub(booked); d >= 0 {
+			timeline.StageTimings[stage] = d
+		}
+	}
+
+	return timeline
+}
+
+// GetStageTimestamps returns when each timed stage was first reached, taken
+// from the first history snapshot that shows it. Like ComputeTimeline, it
+// skips stages already reached in the oldest snapshot.
+func GetStageTimestamps(history *OrderHistory) map[string]time.Time {
+	timestamps := make(map[string]time.Time)
+	if history == nil || len(history.Snapshots) < 2 {
+		return timestamps
+	}
+
+	reached := stagesReached(&history.Snapshots[0].Data)
+	for _, snapshot := range history.Snapshots[1:] {
+		for stage, done := range stagesReached(&snapshot.Data) {
+			if !done || reached[stage] {
+				continue
+			}
+			reached[stage] = true
+			timestamps[stage] = snapshot.Timestamp
+		}
+	}
+
+	return timestamps
+}
+
+// stagesReached reports which timed stages an order snapshot has reached,
+// using the same sequential rules as the timeline view. Empty values count
+// as missing; the task state overrides the rules when it covers a stage.
+func stagesReached(order *CombinedOrder) map[string]bool {
+	known := func(s string) bool { return s != "" && s != "N/A" }
+
+	hasVIN := known(order.Order.GetVIN())
+	hasTransitInfo := known(order.GetETAToDeliveryCenter()) || known(order.GetVehicleLocation())
+	hasAppointment := known(order.GetDeliveryAppointment())
+
+	reached := map[string]bool{
+		StageVINAssigned:      hasVIN,
+		StageInTransit:        hasVIN && hasTransitInfo,
+		StageReadyForDelivery: hasVIN && hasAppointment,
+	}
+	state := order.GetTaskState()
+	for stage := range reached {
+		if complete, ok := state.StageComplete(stage); ok {
+			reached[stage] = complete
+		}
+	}
+	return reached
+}
+
+// parseBookedDate parses an orderBookedDate value
+func parseBookedDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range bookedDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func timelineSnapshot(ts time.Time, vin, location, appointment string) HistoricalSnapshot {
+	order := CombinedOrder{Order: TeslaOrder{VIN: &vin}}
+	order.Details.Tasks.Registration = &RegistrationTask{
+		OrderDetails: &RegistrationOrderDetails{VehicleRoutingLocation: location},
+	}
+	order.Details.Tasks.Scheduling = &SchedulingTask{ApptDateTimeAddressStr: appointment}
+	return HistoricalSnapshot{Timestamp: ts, Data: order}
+}
+
+func timelineOrder(booked string) CombinedOrder {
+	order := CombinedOrder{}
+	order.Details.Tasks.Registration = &RegistrationTask{
+		OrderDetails: &RegistrationOrderDetails{OrderBookedDate: booked},
+	}
+	return order
+}
+
+func TestComputeTimeline(t *testing.T) {
+	booked := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	history := &OrderHistory{Snapshots: []HistoricalSnapshot{
+		timelineSnapshot(booked.Add(2*day), "", "", ""),
+		timelineSnapshot(booked.Add(14*day), "XP7YACEF5TB123456", "", ""),
+		timelineSnapshot(booked.Add(20*day), "XP7YACEF5TB123456", "Tilburg Factory", ""),
+		timelineSnapshot(booked.Add(30*day), "XP7YACEF5TB123456", "Tilburg Factory", "June 15, 2026 at 10:00 AM - Amsterdam"),
+	}}
+
+	timeline := ComputeTimeline(timelineOrder("2026-03-01"), history)
+
+	want := map[string]time.Duration{
+		StageVINAssigned:      14 * day,
+		StageInTransit:        20 * day,
+		StageReadyForDelivery: 30 * day,
+	}
+	for stage, d := range want {
+		if got, ok := timeline.StageTimings[stage]; !ok || got != d {
+			t.Errorf("StageTimings[%q] = %v (present %v), want %v", stage, got, ok, d)
+		}
+	}
+}
+
+func TestComputeTimeline_StageInFirstSnapshotIsUnknown(t *testing.T) {
+	booked := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	history := &OrderHistory{Snapshots: []HistoricalSnapshot{
+		timelineSnapshot(booked.Add(40*24*time.Hour), "XP7YACEF5TB123456", "", ""),
+		timelineSnapshot(booked.Add(45*24*time.Hour), "XP7YACEF5TB123456", "Port", ""),
+	}}
+
+	timeline := ComputeTimeline(timelineOrder("2026-03-01T10:30:00Z"), history)
+
+	if _, ok := timeline.StageTimings[StageVINAssigned]; ok {
+		t.Error("VIN Assigned was reached before tracking started and should have no timing")
+	}
+	if _, ok := timeline.StageTimings[StageInTransit]; !ok {
+		t.Error("In Transit should be timed")
+	}
+}
+
+func TestComputeTimeline_NoData(t *testing.T) {
+	snapshots := []HistoricalSnapshot{
+		timelineSnapshot(time.Now().Add(-time.Hour), "", "", ""),
+		timelineSnapshot(time.Now(), "XP7YACEF5TB123456", "", ""),
+	}
+
+	tests := []struct {
+		name    string
+		order   CombinedOrder
+		history *OrderHistory
+	}{
+		{"nil history", timelineOrder("2026-03-01"), nil},
+		{"single snapshot", timelineOrder("2026-03-01"), &OrderHistory{Snapshots: snapshots[:1]}},
+		{"missing booked date", CombinedOrder{}, &OrderHistory{Snapshots: snapshots}},
+		{"unparsable booked date", timelineOrder("soon"), &OrderHistory{Snapshots: snapshots}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := ComputeTimeline(tt.order, tt.history)
+			if len(timeline.StageTimings) != 0 {
+				t.Errorf("StageTimings = %v, want empty", timeline.StageTimings)
+			}
+		})
+	}
+}
+
+func TestComputeTimeline_TaskStateOverridesHeuristics(t *testing.T) {
+	booked := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	first := timelineSnapshot(booked.Add(2*day), "XP7YACEF5TB123456", "", "")
+	second := timelineSnapshot(booked.Add(9*day), "XP7YACEF5TB123456", "", "")
+	// No routing location yet, but the task state says the car is shipping
+	second.Data.Details.Tasks.State = &TaskState{Stages: map[string]string{"inTransit": "COMPLETE"}}
+
+	timeline := ComputeTimeline(timelineOrder("2026-03-01"), &OrderHistory{Snapshots: []HistoricalSnapshot{first, second}})
+
+	if got, ok := timeline.StageTimings[StageInTransit]; !ok || got != 9*day {
+		t.Errorf("StageTimings[%q] = %v (present %v), want %v", StageInTransit, got, ok, 9*day)
+	}
+}
+
+func TestGetStageTimestamps(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	history := &OrderHistory{Snapshots: []HistoricalSnapshot{
+		timelineSnapshot(start, "", "", ""),
+		timelineSnapshot(start.Add(14*day), "XP7YACEF5TB123456", "", ""),
+		timelineSnapshot(start.Add(15*day), "XP7YACEF5TB123456", "", ""),
+		timelineSnapshot(start.Add(20*day), "XP7YACEF5TB123456", "Tilburg Factory", ""),
+	}}
+
+	got := GetStageTimestamps(history)
+
+	want := map[string]time.Time{
+		StageVINAssigned: start.Add(14 * day),
+		StageInTransit:   start.Add(20 * day),
+	}
+	if len(got) != len(want) {
+		t.Errorf("GetStageTimestamps() = %v, want %v", got, want)
+	}
+	for stage, ts := range want {
+		if !got[stage].Equal(ts) {
+			t.Errorf("timestamp for %q = %v, want %v", stage, got[stage], ts)
+		}
+	}
+}
+
+func TestGetStageTimestamps_NoData(t *testing.T) {
+	if got := GetStageTimestamps(nil); len(got) != 0 {
+		t.Errorf("GetStageTimestamps(nil) = %v, want empty", got)
+	}
+	single := &OrderHistory{Snapshots: []HistoricalSnapshot{
+		timelineSnapshot(time.Now(), "XP7YACEF5TB123456", "", ""),
+	}}
+	if got := GetStageTimestamps(single); len(got) != 0 {
+		t.Errorf("GetStageTimestamps(single snapshot) = %v, want empty", got)
+	}
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -41,6 +44,12 @@ func (o *TeslaOrder) GetVIN() string {
 	return "N/A"
 }
 
+// IsConfigured reports whether the order has option codes. Reservations
+// that haven't been configured yet come without mktOptions.
+func (o *TeslaOrder) IsConfigured() bool {
+	return o.MktOptions != nil && *o.MktOptions != ""
+}
+
 // GetModelName returns a human-readable model name
 func (o *TeslaOrder) GetModelName() string {
 	switch o.ModelCode {
@@ -66,7 +75,8 @@ type TeslaTaskCard struct {
 	MessageBody  string `json:"messageBody,omitempty"`
 	MessageTitle string `json:"messageTitle,omitempty"`
 	ButtonText   *struct {
-		CTA string `json:"cta"`
+		CTA    string `json:"cta"`
+		Target string `json:"target,omitempty"`
 	} `json:"buttonText,omitempty"`
 	Target string `json:"target,omitempty"`
 }
@@ -81,6 +91,15 @@ type TeslaTask struct {
 	Card     *TeslaTaskCard `json:"card,omitempty"`
 }
 
+// GetActionURL returns the URL behind the task card's button, or "" when the
+// task has no action link
+func (t *TeslaTask) GetActionURL() string {
+	if t.Card == nil || t.Card.ButtonText == nil {
+		return ""
+	}
+	return t.Card.ButtonText.Target
+}
+
 // SchedulingTask represents scheduling-specific task data
 type SchedulingTask struct {
 	TeslaTask
@@ -127,6 +146,42 @@ func ParseAppointment(raw string) *AppointmentDetails {
 	}
 }
 
+// appointmentLayouts are the date/time formats accepted by ParseAppointmentTime
+var appointmentLayouts = []string{
+	"January 2, 2006 3:04 PM",
+	"January 2, 2006 03:04 PM",
+	"January 2, 2006",
+	"Jan 2, 2006 3:04 PM",
+	"Jan 2, 2006",
+	"2006-01-02",
+}
+
+// ParseAppointmentTime parses the date and time of an appointment as UTC.
+// Returns false if the date is not in a recognised format.
+func ParseAppointmentTime(appt *AppointmentDetails) (time.Time, bool) {
+	return ParseAppointmentTimeIn(appt, time.UTC)
+}
+
+// ParseAppointmentTimeIn parses the date and time of an appointment in the
+// given location, since the API reports local times without a zone
+func ParseAppointmentTimeIn(appt *AppointmentDetails, loc *time.Location) (time.Time, bool) {
+	if appt == nil {
+		return time.Time{}, false
+	}
+
+	dateStr := appt.Date
+	if appt.Time != "" {
+		dateStr = appt.Date + " " + appt.Time
+	}
+
+	for _, layout := range appointmentLayouts {
+		if t, err := time.ParseInLocation(layout, dateStr, loc); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // RegistrationOrderDetails contains order details from registration task
 type RegistrationOrderDetails struct {
 	VehicleRoutingLocation string `json:"vehicleRoutingLocation,omitempty"`
@@ -134,6 +189,16 @@ type RegistrationOrderDetails struct {
 	VehicleOdometerType    string `json:"vehicleOdometerType,omitempty"`
 	ReservationDate        string `json:"reservationDate,omitempty"`
 	OrderBookedDate        string `json:"orderBookedDate,omitempty"`
+	// Kept without omitempty so snapshots tell "no adjustments" (an empty
+	// list) apart from snapshots saved before adjustments were stored (null)
+	OrderAdjustments []OrderAdjustment `json:"orderAdjustments"`
+	CurrencyFormat   *CurrencyFormat   `json:"currencyFormat,omitempty"`
+}
+
+// OrderAdjustment is a credit or charge on the order, such as a referral
+type OrderAdjustment struct {
+	Label  string      `json:"label"`
+	Amount json.Number `json:"amount"`
 }
 
 // RegistrationTask represents registration-specific task data
@@ -147,10 +212,17 @@ type FinalPaymentData struct {
 	ETAToDeliveryCenter string `json:"etaToDeliveryCenter,omitempty"`
 }
 
+// CurrencyFormat describes the currency used for amounts in a task
+type CurrencyFormat struct {
+	CurrencyCode string `json:"currencyCode,omitempty"`
+}
+
 // FinalPaymentTask represents final payment task data
 type FinalPaymentTask struct {
 	TeslaTask
-	Data *FinalPaymentData `json:"data,omitempty"`
+	Data           *FinalPaymentData `json:"data,omitempty"`
+	AmountDue      json.Number       `json:"amountDue,omitempty"`
+	CurrencyFormat *CurrencyFormat   `json:"currencyFormat,omitempty"`
 }
 
 // DeliveryDetailsRegData contains registration data
@@ -164,12 +236,118 @@ type DeliveryDetailsTask struct {
 	RegData *DeliveryDetailsRegData `json:"regData,omitempty"`
 }
 
+// TaskState is the top-level "state" entry of the tasks map, which maps
+// stage names to their completion state
+type TaskState struct {
+	Stages map[string]string
+}
+
+// UnmarshalJSON reads the state object, keeping scalar values as strings and
+// skipping nested objects
+func (s *TaskState) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	s.Stages = make(map[string]string, len(raw))
+	for name, value := range raw {
+		switch v := value.(type) {
+		case string:
+			s.Stages[name] = v
+		case bool, float64:
+			s.Stages[name] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the stages back as a flat object
+func (s TaskState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Stages)
+}
+
+// taskStateKeys maps timeline stages to the state keys that describe them,
+// normalized to lower case without separators, in order of preference
+var taskStateKeys = map[string][]string{
+	StageVINAssigned:      {"vinassigned"},
+	StageInTransit:        {"intransit", "transit", "shipping"},
+	StageReadyForDelivery: {"readyfordelivery", "deliveryready"},
+	StageDelivered:        {"delivered"},
+}
+
+// stateCompletion maps recognised state values, normalized like keys, to
+// whether they mark a stage as complete
+var stateCompletion = map[string]bool{
+	"complete":   true,
+	"completed":  true,
+	"done":       true,
+	"finished":   true,
+	"true":       true,
+	"incomplete": false,
+	"inprogress": false,
+	"pending":    false,
+	"notstarted": false,
+	"open":       false,
+	"false":      false,
+}
+
+// normalizeStateKey lower-cases a state key and drops separators
+func normalizeStateKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(key))
+}
+
+// StageComplete reports whether the state marks a timeline stage as complete.
+// known is false when the state says nothing about the stage, or only holds
+// values that aren't a recognised status. Keys are tried in preference order
+// and, within a key, state names in sorted order.
+func (s *TaskState) StageComplete(stage string) (complete, known bool) {
+	if s == nil {
+		return false, false
+	}
+	names := make([]string, 0, len(s.Stages))
+	for name := range s.Stages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, key := range taskStateKeys[stage] {
+		for _, name := range names {
+			if normalizeStateKey(name) != key {
+				continue
+			}
+			if complete, ok := stateCompletion[normalizeStateKey(s.Stages[name])]; ok {
+				return complete, true
+			}
+		}
+	}
+	return false, false
+}
+
+// String lists the stages as "name: value" pairs in name order
+func (s *TaskState) String() string {
+	if s == nil || len(s.Stages) == 0 {
+		return "N/A"
+	}
+	names := make([]string, 0, len(s.Stages))
+	for name := range s.Stages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + ": " + s.Stages[name]
+	}
+	return strings.Join(parts, ", ")
+}
+
 // OrderTasks contains all the tasks associated with an order
 type OrderTasks struct {
 	Scheduling       *SchedulingTask      `json:"scheduling,omitempty"`
 	Registration     *RegistrationTask    `json:"registration,omitempty"`
 	FinalPayment     *FinalPaymentTask    `json:"finalPayment,omitempty"`
 	DeliveryDetails  *DeliveryDetailsTask `json:"deliveryDetails,omitempty"`
+	Insurance        *TeslaTask           `json:"insurance,omitempty"`
+	State            *TaskState           `json:"state,omitempty"`
 	// Generic map for other tasks we might not have typed
 	Raw map[string]json.RawMessage `json:"-"`
 }
@@ -194,6 +372,15 @@ func (c *CombinedOrder) GetDeliveryWindow() string {
 	return "N/A"
 }
 
+// GetSelfSchedulingURL returns the link to schedule the delivery online, or
+// "" when self-scheduling isn't available
+func (c *CombinedOrder) GetSelfSchedulingURL() string {
+	if c.Details.Tasks.Scheduling != nil && c.Details.Tasks.Scheduling.IsSelfSchedulingAvailable {
+		return c.Details.Tasks.Scheduling.SelfSchedulingURL
+	}
+	return ""
+}
+
 // GetDeliveryAppointment returns the appointment date/time/address
 func (c *CombinedOrder) GetDeliveryAppointment() string {
 	if c.Details.Tasks.Scheduling != nil && c.Details.Tasks.Scheduling.ApptDateTimeAddressStr != "" {
@@ -234,6 +421,284 @@ func (c *CombinedOrder) GetDeliveryCenter() string {
 	return "N/A"
 }
 
+// GetPaymentStatus returns the amount due, currency code and completion state
+// of the finalPayment task. The typed task is preferred; the raw task JSON is
+// used when only that is available. Fractional amounts are truncated.
+func (c *CombinedOrder) GetPaymentStatus() (amountDue int64, currencyCode string, complete bool) {
+	payment := c.Details.Tasks.FinalPayment
+	if payment == nil {
+		raw, ok := c.Details.Tasks.Raw["finalPayment"]
+		if !ok {
+			return 0, "", false
+		}
+		payment = &FinalPaymentTask{}
+		if err := json.Unmarshal(raw, payment); err != nil {
+			return 0, "", false
+		}
+	}
+
+	if payment.CurrencyFormat != nil {
+		currencyCode = payment.CurrencyFormat.CurrencyCode
+	}
+
+	if amount, err := payment.AmountDue.Int64(); err == nil && amount > 0 {
+		amountDue = amount
+	} else if amount, err := payment.AmountDue.Float64(); err == nil && amount > 0 {
+		amountDue = int64(amount)
+	}
+
+	return amountDue, currencyCode, payment.Complete
+}
+
+// GetAmountDue returns the amount due with its currency code for display and
+// comparison, or "N/A" when there is no finalPayment task
+func (c *CombinedOrder) GetAmountDue() string {
+	if c.Details.Tasks.FinalPayment == nil {
+		if _, ok := c.Details.Tasks.Raw["finalPayment"]; !ok {
+			return "N/A"
+		}
+	}
+
+	amount, currency, _ := c.GetPaymentStatus()
+	return strings.TrimSpace(fmt.Sprintf("%d %s", amount, currency))
+}
+
+// hasPaymentData reports whether the order records the amount due.
+// Snapshots saved before it was stored only have the payment ETA.
+func (c *CombinedOrder) hasPaymentData() bool {
+	if _, ok := c.Details.Tasks.Raw["finalPayment"]; ok {
+		return true
+	}
+	payment := c.Details.Tasks.FinalPayment
+	return payment != nil && (payment.AmountDue != "" || payment.CurrencyFormat != nil)
+}
+
+// GetReferralCredit returns the absolute amount and currency code of the
+// first registration order adjustment labelled as a referral. The currency
+// falls back to the finalPayment task when the registration task has none.
+// Returns 0 and "" when there is no referral adjustment.
+func (c *CombinedOrder) GetReferralCredit() (amount int64, currencyCode string) {
+	details := c.registrationOrderDetails()
+	if details == nil {
+		return 0, ""
+	}
+
+	for _, adj := range details.OrderAdjustments {
+		if !strings.Contains(strings.ToLower(adj.Label), "referral") {
+			continue
+		}
+		if n, err := adj.Amount.Int64(); err == nil {
+			amount = n
+		} else if f, err := adj.Amount.Float64(); err == nil {
+			amount = int64(f)
+		}
+		if amount < 0 {
+			amount = -amount
+		}
+
+		if details.CurrencyFormat != nil {
+			currencyCode = details.CurrencyFormat.CurrencyCode
+		}
+		if currencyCode == "" {
+			_, currencyCode, _ = c.GetPaymentStatus()
+		}
+		return amount, currencyCode
+	}
+	return 0, ""
+}
+
+// registrationOrderDetails returns the registration order details. The
+// typed task is preferred once it holds the adjustments; otherwise the raw
+// task JSON is used when available.
+func (c *CombinedOrder) registrationOrderDetails() *RegistrationOrderDetails {
+	var typed *RegistrationOrderDetails
+	if c.Details.Tasks.Registration != nil {
+		typed = c.Details.Tasks.Registration.OrderDetails
+	}
+	if typed != nil && typed.OrderAdjustments != nil {
+		return typed
+	}
+
+	if raw, ok := c.Details.Tasks.Raw["registration"]; ok {
+		var reg RegistrationTask
+		if err := json.Unmarshal(raw, &reg); err == nil && reg.OrderDetails != nil {
+			return reg.OrderDetails
+		}
+	}
+	return typed
+}
+
+// hasReferralData reports whether the order records its adjustments.
+// Snapshots saved before adjustments were stored don't.
+func (c *CombinedOrder) hasReferralData() bool {
+	details := c.registrationOrderDetails()
+	return details != nil && details.OrderAdjustments != nil
+}
+
+// referralCredit returns the referral credit for comparison
+func (c *CombinedOrder) referralCredit() string {
+	amount, currency := c.GetReferralCredit()
+	if amount == 0 {
+		return "N/A"
+	}
+	return strings.TrimSpace(fmt.Sprintf("%d %s", amount, currency))
+}
+
+// GetInsuranceStatus reports whether the insurance task is enabled and
+// complete. The typed task is preferred; the raw task JSON is used when only
+// that is available.
+func (c *CombinedOrder) GetInsuranceStatus() (enabled, complete bool) {
+	insurance := c.Details.Tasks.Insurance
+	if insurance == nil {
+		raw, ok := c.Details.Tasks.Raw["insurance"]
+		if !ok {
+			return false, false
+		}
+		insurance = &TeslaTask{}
+		if err := json.Unmarshal(raw, insurance); err != nil {
+			return false, false
+		}
+	}
+	return insurance.Enabled, insurance.Complete
+}
+
+// hasInsuranceData reports whether the order has an insurance task.
+// Snapshots saved before the task was stored don't.
+func (c *CombinedOrder) hasInsuranceData() bool {
+	if _, ok := c.Details.Tasks.Raw["insurance"]; ok {
+		return true
+	}
+	return c.Details.Tasks.Insurance != nil
+}
+
+// insuranceState returns the insurance task state for comparison
+func (c *CombinedOrder) insuranceState() string {
+	enabled, complete := c.GetInsuranceStatus()
+	switch {
+	case !enabled:
+		return "N/A"
+	case complete:
+		return "Complete"
+	default:
+		return "Incomplete"
+	}
+}
+
+// GetTaskState returns the tasks "state" entry, falling back to the raw task
+// data when it was not parsed. Returns nil when the API did not send one.
+func (c *CombinedOrder) GetTaskState() *TaskState {
+	if c.Details.Tasks.State != nil {
+		return c.Details.Tasks.State
+	}
+
+	data, ok := c.Details.Tasks.Raw["state"]
+	if !ok {
+		tasks, _ := c.Details.RawJSON["tasks"].(map[string]interface{})
+		value, found := tasks["state"]
+		if !found {
+			return nil
+		}
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil
+		}
+	}
+
+	var state TaskState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil
+	}
+	return &state
+}
+
+// taskMetadataKeys are entries in the tasks response that aren't tasks
+var taskMetadataKeys = map[string]bool{
+	"state":   true,
+	"strings": true,
+}
+
+// GetAllTaskStatuses returns whether each task in the raw task data is
+// complete, keyed by task name. Metadata entries and tasks that cannot be
+// parsed are left out.
+func (c *CombinedOrder) GetAllTaskStatuses() map[string]bool {
+	statuses := make(map[string]bool)
+	for name, raw := range c.Details.Tasks.Raw {
+		if taskMetadataKeys[name] {
+			continue
+		}
+		var task struct {
+			Complete bool `json:"complete"`
+		}
+		if err := json.Unmarshal(raw, &task); err != nil {
+			continue
+		}
+		statuses[name] = task.Complete
+	}
+	return statuses
+}
+
+// GetBlockingTasks returns the sorted names of the required tasks that are
+// not complete yet
+func (c *CombinedOrder) GetBlockingTasks() []string {
+	var blocking []string
+	for name, raw := range c.Details.Tasks.Raw {
+		if taskMetadataKeys[name] {
+			continue
+		}
+		var task struct {
+			Complete bool `json:"complete"`
+			Required bool `json:"required"`
+		}
+		if err := json.Unmarshal(raw, &task); err != nil {
+			continue
+		}
+		if task.Required && !task.Complete {
+			blocking = append(blocking, name)
+		}
+	}
+	sort.Strings(blocking)
+	return blocking
+}
+
+// GetIsUsed reports whether the order is for a pre-owned inventory vehicle
+func (c *CombinedOrder) GetIsUsed() bool {
+	return c.Order.IsUsed
+}
+
+// GetUsedVehicleDetails returns the model year and condition of a pre-owned
+// vehicle from the registration task's orderDetails.preOwned entry. The year
+// falls back to the model year encoded in the VIN. Returns 0 and "" for new
+// vehicles.
+func (c *CombinedOrder) GetUsedVehicleDetails() (year int, condition string) {
+	if !c.GetIsUsed() {
+		return 0, ""
+	}
+
+	if raw, ok := c.Details.Tasks.Raw["registration"]; ok {
+		var reg struct {
+			OrderDetails *struct {
+				PreOwned *struct {
+					ModelYear json.Number `json:"modelYear"`
+					Condition string      `json:"condition"`
+				} `json:"preOwned"`
+			} `json:"orderDetails"`
+		}
+		if err := json.Unmarshal(raw, &reg); err == nil && reg.OrderDetails != nil && reg.OrderDetails.PreOwned != nil {
+			if y, err := reg.OrderDetails.PreOwned.ModelYear.Int64(); err == nil {
+				year = int(y)
+			}
+			condition = reg.OrderDetails.PreOwned.Condition
+		}
+	}
+
+	if year == 0 {
+		if info := DecodeVIN(c.Order.GetVIN()); info != nil {
+			year, _ = strconv.Atoi(info.ModelYear)
+		}
+	}
+	return year, condition
+}
+
 // GetOdometer returns the vehicle odometer reading
 func (c *CombinedOrder) GetOdometer() string {
 	if c.Details.Tasks.Registration != nil && c.Details.Tasks.Registration.OrderDetails != nil {
@@ -248,6 +713,45 @@ func (c *CombinedOrder) GetOdometer() string {
 	return "N/A"
 }
 
+// Unit systems accepted by GetFormattedOdometer
+const (
+	UnitsMetric   = "metric"
+	UnitsImperial = "imperial"
+)
+
+const milesPerKm = 0.621371
+
+// GetFormattedOdometer returns the odometer reading in the requested unit
+// system, converting when the API reports the other one. An empty or unknown
+// units value returns the reading as reported.
+func (c *CombinedOrder) GetFormattedOdometer(units string) string {
+	if c.Details.Tasks.Registration == nil || c.Details.Tasks.Registration.OrderDetails == nil {
+		return c.GetOdometer()
+	}
+	od := c.Details.Tasks.Registration.OrderDetails
+
+	var apiUnits string
+	switch strings.ToLower(od.VehicleOdometerType) {
+	case "km", "kms", "kilometers", "kilometres":
+		apiUnits = UnitsMetric
+	case "mi", "mile", "miles":
+		apiUnits = UnitsImperial
+	}
+	if apiUnits == "" || units == apiUnits || (units != UnitsMetric && units != UnitsImperial) {
+		return c.GetOdometer()
+	}
+
+	value, err := strconv.ParseFloat(strings.ReplaceAll(od.VehicleOdometer, ",", ""), 64)
+	if err != nil {
+		return c.GetOdometer()
+	}
+
+	if units == UnitsImperial {
+		return fmt.Sprintf("%.2f mi", value*milesPerKm)
+	}
+	return fmt.Sprintf("%.2f km", value/milesPerKm)
+}
+
 // GetLicensePlate returns the assigned license plate
 func (c *CombinedOrder) GetLicensePlate() string {
 	if c.Details.Tasks.DeliveryDetails != nil && c.Details.Tasks.DeliveryDetails.RegData != nil {
@@ -324,6 +828,19 @@ func CompareOrders(old, new CombinedOrder) []OrderDiff {
 	addDiff("License Plate", old.GetLicensePlate(), new.GetLicensePlate())
 	addDiff("Reservation Date", old.GetReservationDate(), new.GetReservationDate())
 	addDiff("Order Booked Date", old.GetOrderBookedDate(), new.GetOrderBookedDate())
+	// Snapshots saved before the amount was stored would flag every order
+	if old.hasPaymentData() {
+		addDiff("Amount Due", old.GetAmountDue(), new.GetAmountDue())
+	}
+	// Old snapshots without adjustments would otherwise report the credit as
+	// new on every refresh
+	if old.hasReferralData() {
+		addDiff("Referral Credit", old.referralCredit(), new.referralCredit())
+	}
+	if old.hasInsuranceData() {
+		addDiff("Insurance", old.insuranceState(), new.insuranceState())
+	}
+	addDiff("Task State", old.GetTaskState().String(), new.GetTaskState().String())
 
 	// Compare MktOptions via pointer
 	oldOpts := "N/A"
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func FuzzParseAppointment(f *testing.F) {
+	seeds := []string{
+		"August 15, 2024 at 10:00 AM - Tesla Delivery Center, 123 Electric Ave",
+		"June 15, 2026 at 10:00 AM",
+		"June 15, 2026",
+		"N/A",
+		"",
+		" at ",
+		" - ",
+		"at - at - at",
+		" at  - ",
+		"\xff at \xfe - \xfd",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		appt := ParseAppointment(raw)
+		if raw == "" || raw == "N/A" {
+			if appt != nil {
+				t.Errorf("ParseAppointment(%q) = %+v, want nil", raw, appt)
+			}
+			return
+		}
+		if appt == nil {
+			t.Fatalf("ParseAppointment(%q) = nil, want non-nil", raw)
+		}
+		for _, field := range []string{appt.Date, appt.Time, appt.Address} {
+			if field != strings.TrimSpace(field) {
+				t.Errorf("ParseAppointment(%q) returned untrimmed field %q", raw, field)
+			}
+		}
+	})
+}
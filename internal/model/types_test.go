@@ -1,6 +1,8 @@
 package model
 
 import (
+	"encoding/json"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -81,6 +83,30 @@ func TestTeslaOrder_GetVIN(t *testing.T) {
 	}
 }
 
+func TestTeslaOrder_IsConfigured(t *testing.T) {
+	options := "APBS,IPB8,MDLY"
+	empty := ""
+
+	tests := []struct {
+		name       string
+		mktOptions *string
+		want       bool
+	}{
+		{"with options", &options, true},
+		{"empty options", &empty, false},
+		{"nil options", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := TeslaOrder{MktOptions: tt.mktOptions}
+			if got := order.IsConfigured(); got != tt.want {
+				t.Errorf("IsConfigured() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTeslaOrder_GetModelName(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -167,6 +193,28 @@ func TestCombinedOrder_GetDeliveryWindow(t *testing.T) {
 	}
 }
 
+func TestCombinedOrder_GetSelfSchedulingURL(t *testing.T) {
+	url := "https://www.tesla.com/teslaaccount/schedule"
+	tests := []struct {
+		name       string
+		scheduling *SchedulingTask
+		want       string
+	}{
+		{"available", &SchedulingTask{IsSelfSchedulingAvailable: true, SelfSchedulingURL: url}, url},
+		{"not available", &SchedulingTask{SelfSchedulingURL: url}, ""},
+		{"nil scheduling", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{Scheduling: tt.scheduling}}}
+			if got := order.GetSelfSchedulingURL(); got != tt.want {
+				t.Errorf("GetSelfSchedulingURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCombinedOrder_GetDeliveryAppointment(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -287,6 +335,47 @@ func TestCombinedOrder_GetVehicleLocation(t *testing.T) {
 	}
 }
 
+func TestCombinedOrder_GetFormattedOdometer(t *testing.T) {
+	withOdometer := func(value, unit string) CombinedOrder {
+		return CombinedOrder{
+			Details: OrderDetails{
+				Tasks: OrderTasks{
+					Registration: &RegistrationTask{
+						OrderDetails: &RegistrationOrderDetails{
+							VehicleOdometer:     value,
+							VehicleOdometerType: unit,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		order CombinedOrder
+		units string
+		want  string
+	}{
+		{"km to miles", withOdometer("50", "km"), UnitsImperial, "31.07 mi"},
+		{"km to miles large", withOdometer("1,234", "KM"), UnitsImperial, "766.77 mi"},
+		{"miles to km", withOdometer("10", "mi"), UnitsMetric, "16.09 km"},
+		{"same unit unchanged", withOdometer("50", "km"), UnitsMetric, "50 km"},
+		{"empty units unchanged", withOdometer("50", "km"), "", "50 km"},
+		{"unknown API unit unchanged", withOdometer("50", ""), UnitsImperial, "50"},
+		{"non-numeric unchanged", withOdometer("n/a", "km"), UnitsImperial, "n/a km"},
+		{"nil registration", CombinedOrder{}, UnitsImperial, "N/A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.GetFormattedOdometer(tt.units); got != tt.want {
+				t.Errorf("GetFormattedOdometer(%q) = %v, want %v", tt.units, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCombinedOrder_GetOdometer(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -734,7 +823,9 @@ func TestCompareOrders_AllFields(t *testing.T) {
 					Data: &FinalPaymentData{
 						ETAToDeliveryCenter: "Jan 10",
 					},
+					AmountDue: "40000",
 				},
+				Insurance: &TeslaTask{Enabled: true},
 				Registration: &RegistrationTask{
 					OrderDetails: &RegistrationOrderDetails{
 						VehicleRoutingLocation: "Factory",
@@ -742,6 +833,7 @@ func TestCompareOrders_AllFields(t *testing.T) {
 						VehicleOdometerType:    "km",
 						ReservationDate:        "2024-01-01",
 						OrderBookedDate:        "2024-01-05",
+						OrderAdjustments:       []OrderAdjustment{},
 					},
 				},
 				DeliveryDetails: &DeliveryDetailsTask{
@@ -771,7 +863,9 @@ func TestCompareOrders_AllFields(t *testing.T) {
 					Data: &FinalPaymentData{
 						ETAToDeliveryCenter: "Feb 15",
 					},
+					AmountDue: "39120",
 				},
+				Insurance: &TeslaTask{Enabled: true, Complete: true},
 				Registration: &RegistrationTask{
 					OrderDetails: &RegistrationOrderDetails{
 						VehicleRoutingLocation: "Port",
@@ -786,6 +880,9 @@ func TestCompareOrders_AllFields(t *testing.T) {
 						ReggieLicensePlate: "CC-222-DD",
 					},
 				},
+				Raw: map[string]json.RawMessage{
+					"registration": json.RawMessage(`{"orderDetails": {"orderAdjustments": [{"label": "Referral Credit", "amount": -500}]}}`),
+				},
 			},
 		},
 	}
@@ -805,6 +902,9 @@ func TestCompareOrders_AllFields(t *testing.T) {
 		"License Plate":          true,
 		"Reservation Date":       true,
 		"Order Booked Date":      true,
+		"Amount Due":             true,
+		"Referral Credit":        true,
+		"Insurance":              true,
 		"Vehicle Options":        true,
 	}
 
@@ -857,3 +957,497 @@ func TestCompareOrders_MktOptions_NilHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestCombinedOrder_GetPaymentStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		tasks        OrderTasks
+		wantAmount   int64
+		wantCurrency string
+		wantComplete bool
+	}{
+		{
+			name:  "nil tasks",
+			tasks: OrderTasks{},
+		},
+		{
+			name: "zero amount",
+			tasks: OrderTasks{
+				FinalPayment: &FinalPaymentTask{
+					TeslaTask:      TeslaTask{Complete: true},
+					AmountDue:      "0",
+					CurrencyFormat: &CurrencyFormat{CurrencyCode: "EUR"},
+				},
+			},
+			wantCurrency: "EUR",
+			wantComplete: true,
+		},
+		{
+			name: "typed task with currency",
+			tasks: OrderTasks{
+				FinalPayment: &FinalPaymentTask{
+					AmountDue:      "39120",
+					CurrencyFormat: &CurrencyFormat{CurrencyCode: "EUR"},
+				},
+			},
+			wantAmount:   39120,
+			wantCurrency: "EUR",
+		},
+		{
+			name: "raw task only",
+			tasks: OrderTasks{
+				Raw: map[string]json.RawMessage{
+					"finalPayment": json.RawMessage(`{"complete": true, "amountDue": 52990, "currencyFormat": {"currencyCode": "USD"}}`),
+				},
+			},
+			wantAmount:   52990,
+			wantCurrency: "USD",
+			wantComplete: true,
+		},
+		{
+			name: "fractional amount without currency",
+			tasks: OrderTasks{
+				Raw: map[string]json.RawMessage{
+					"finalPayment": json.RawMessage(`{"amountDue": 1500.75}`),
+				},
+			},
+			wantAmount: 1500,
+		},
+		{
+			name: "invalid raw json",
+			tasks: OrderTasks{
+				Raw: map[string]json.RawMessage{
+					"finalPayment": json.RawMessage(`not json`),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: tt.tasks}}
+			amount, currency, complete := order.GetPaymentStatus()
+			if amount != tt.wantAmount {
+				t.Errorf("amountDue = %d, want %d", amount, tt.wantAmount)
+			}
+			if currency != tt.wantCurrency {
+				t.Errorf("currencyCode = %q, want %q", currency, tt.wantCurrency)
+			}
+			if complete != tt.wantComplete {
+				t.Errorf("complete = %v, want %v", complete, tt.wantComplete)
+			}
+		})
+	}
+}
+
+func TestCombinedOrder_GetAmountDue(t *testing.T) {
+	tests := []struct {
+		name  string
+		tasks OrderTasks
+		want  string
+	}{
+		{"no final payment", OrderTasks{}, "N/A"},
+		{"zero amount", OrderTasks{FinalPayment: &FinalPaymentTask{}}, "0"},
+		{
+			"amount with currency",
+			OrderTasks{FinalPayment: &FinalPaymentTask{AmountDue: "39120", CurrencyFormat: &CurrencyFormat{CurrencyCode: "EUR"}}},
+			"39120 EUR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: tt.tasks}}
+			if got := order.GetAmountDue(); got != tt.want {
+				t.Errorf("GetAmountDue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinedOrder_GetInsuranceStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		tasks        OrderTasks
+		wantEnabled  bool
+		wantComplete bool
+	}{
+		{"nil raw map", OrderTasks{}, false, false},
+		{"missing insurance key", OrderTasks{Raw: map[string]json.RawMessage{"finalPayment": json.RawMessage(`{}`)}}, false, false},
+		{"raw incomplete", OrderTasks{Raw: map[string]json.RawMessage{"insurance": json.RawMessage(`{"enabled": true, "complete": false}`)}}, true, false},
+		{"raw complete", OrderTasks{Raw: map[string]json.RawMessage{"insurance": json.RawMessage(`{"enabled": true, "complete": true}`)}}, true, true},
+		{"typed task preferred", OrderTasks{
+			Insurance: &TeslaTask{Enabled: true, Complete: true},
+			Raw:       map[string]json.RawMessage{"insurance": json.RawMessage(`{"enabled": false}`)},
+		}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: tt.tasks}}
+			enabled, complete := order.GetInsuranceStatus()
+			if enabled != tt.wantEnabled || complete != tt.wantComplete {
+				t.Errorf("GetInsuranceStatus() = %v, %v; want %v, %v", enabled, complete, tt.wantEnabled, tt.wantComplete)
+			}
+		})
+	}
+}
+
+func TestCombinedOrder_GetReferralCredit(t *testing.T) {
+	registration := func(orderDetails string) map[string]json.RawMessage {
+		return map[string]json.RawMessage{"registration": json.RawMessage(`{"orderDetails": ` + orderDetails + `}`)}
+	}
+
+	tests := []struct {
+		name         string
+		raw          map[string]json.RawMessage
+		wantAmount   int64
+		wantCurrency string
+	}{
+		{"no registration task", nil, 0, ""},
+		{"no adjustments", registration(`{"currencyFormat": {"currencyCode": "EUR"}}`), 0, ""},
+		{"absent referral", registration(`{"orderAdjustments": [{"label": "Discount", "amount": -500}]}`), 0, ""},
+		{"zero amount", registration(`{"orderAdjustments": [{"label": "Referral Credit", "amount": 0}], "currencyFormat": {"currencyCode": "EUR"}}`), 0, "EUR"},
+		{"negative amount", registration(`{"orderAdjustments": [{"label": "Referral Credit", "amount": -2500}], "currencyFormat": {"currencyCode": "EUR"}}`), 2500, "EUR"},
+		{"positive fractional amount", registration(`{"orderAdjustments": [{"label": "referral bonus", "amount": 1000.50}], "currencyFormat": {"currencyCode": "USD"}}`), 1000, "USD"},
+		{"currency from finalPayment", map[string]json.RawMessage{
+			"registration": json.RawMessage(`{"orderDetails": {"orderAdjustments": [{"label": "REFERRAL", "amount": -750}]}}`),
+			"finalPayment": json.RawMessage(`{"currencyFormat": {"currencyCode": "GBP"}}`),
+		}, 750, "GBP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{Raw: tt.raw}}}
+			amount, currency := order.GetReferralCredit()
+			if amount != tt.wantAmount || currency != tt.wantCurrency {
+				t.Errorf("GetReferralCredit() = %d, %q; want %d, %q", amount, currency, tt.wantAmount, tt.wantCurrency)
+			}
+		})
+	}
+}
+
+func TestCompareOrders_AmountDueMissingFromSnapshot(t *testing.T) {
+	// Snapshots saved before the amount due was stored
+	legacy := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		FinalPayment: &FinalPaymentTask{Data: &FinalPaymentData{ETAToDeliveryCenter: "June 10"}},
+	}}}
+	current := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		FinalPayment: &FinalPaymentTask{
+			Data:           &FinalPaymentData{ETAToDeliveryCenter: "June 10"},
+			AmountDue:      "42990",
+			CurrencyFormat: &CurrencyFormat{CurrencyCode: "EUR"},
+		},
+	}}}
+
+	if diffs := CompareOrders(legacy, current); len(diffs) != 0 {
+		t.Errorf("CompareOrders(legacy, current) = %v, want no diffs", diffs)
+	}
+	for _, diff := range CompareOrders(CombinedOrder{}, current) {
+		if diff.Field == "Amount Due" {
+			t.Errorf("Amount Due should not be compared without a payment task in the snapshot, got %v", diff)
+		}
+	}
+}
+
+func TestCompareOrders_InsuranceMissingFromSnapshot(t *testing.T) {
+	current := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		Insurance: &TeslaTask{Enabled: true, Complete: true},
+	}}}
+	if diffs := CompareOrders(CombinedOrder{}, current); len(diffs) != 0 {
+		t.Errorf("CompareOrders(legacy, current) = %v, want no diffs", diffs)
+	}
+
+	pending := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		Insurance: &TeslaTask{Enabled: true},
+	}}}
+	diffs := CompareOrders(pending, current)
+	if len(diffs) != 1 || diffs[0].Field != "Insurance" {
+		t.Errorf("CompareOrders(pending, current) = %v, want the insurance change", diffs)
+	}
+}
+
+func TestCompareOrders_ReferralCreditFromSnapshot(t *testing.T) {
+	current := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		Registration: &RegistrationTask{OrderDetails: &RegistrationOrderDetails{
+			OrderAdjustments: []OrderAdjustment{{Label: "Referral Credit", Amount: "-2500"}},
+			CurrencyFormat:   &CurrencyFormat{CurrencyCode: "EUR"},
+		}},
+	}}}
+
+	// The adjustments survive a round trip through a history snapshot
+	data, err := json.Marshal(current)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var snapshot CombinedOrder
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if amount, currency := snapshot.GetReferralCredit(); amount != 2500 || currency != "EUR" {
+		t.Errorf("snapshot GetReferralCredit() = %d, %q; want 2500, EUR", amount, currency)
+	}
+	if diffs := CompareOrders(snapshot, current); len(diffs) != 0 {
+		t.Errorf("CompareOrders(snapshot, current) = %v, want no diffs", diffs)
+	}
+
+	// Snapshots saved before adjustments were stored are not compared
+	legacy := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		Registration: &RegistrationTask{OrderDetails: &RegistrationOrderDetails{}},
+	}}}
+	if diffs := CompareOrders(legacy, current); len(diffs) != 0 {
+		t.Errorf("CompareOrders(legacy, current) = %v, want no diffs", diffs)
+	}
+
+	// An order without a referral gaining one is reported
+	none := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		Registration: &RegistrationTask{OrderDetails: &RegistrationOrderDetails{OrderAdjustments: []OrderAdjustment{}}},
+	}}}
+	diffs := CompareOrders(none, current)
+	if len(diffs) != 1 || diffs[0].Field != "Referral Credit" || diffs[0].NewValue != "2500 EUR" {
+		t.Errorf("CompareOrders(none, current) = %v, want the new referral credit", diffs)
+	}
+}
+
+func TestCombinedOrder_GetUsedVehicleDetails(t *testing.T) {
+	vin := "LRW3E7EK2NC654321" // model year N = 2022
+	registration := map[string]json.RawMessage{
+		"registration": json.RawMessage(`{"orderDetails": {"preOwned": {"modelYear": 2021, "condition": "Good"}}}`),
+	}
+
+	tests := []struct {
+		name          string
+		order         CombinedOrder
+		wantUsed      bool
+		wantYear      int
+		wantCondition string
+	}{
+		{"new vehicle", CombinedOrder{Order: TeslaOrder{VIN: &vin}, Details: OrderDetails{Tasks: OrderTasks{Raw: registration}}}, false, 0, ""},
+		{"used with details", CombinedOrder{Order: TeslaOrder{IsUsed: true, VIN: &vin}, Details: OrderDetails{Tasks: OrderTasks{Raw: registration}}}, true, 2021, "Good"},
+		{"used, year from VIN", CombinedOrder{Order: TeslaOrder{IsUsed: true, VIN: &vin}}, true, 2022, ""},
+		{"used without VIN", CombinedOrder{Order: TeslaOrder{IsUsed: true}}, true, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.order.GetIsUsed(); got != tt.wantUsed {
+				t.Errorf("GetIsUsed() = %v, want %v", got, tt.wantUsed)
+			}
+			year, condition := tt.order.GetUsedVehicleDetails()
+			if year != tt.wantYear || condition != tt.wantCondition {
+				t.Errorf("GetUsedVehicleDetails() = %d, %q; want %d, %q", year, condition, tt.wantYear, tt.wantCondition)
+			}
+		})
+	}
+}
+
+func TestCombinedOrder_GetAllTaskStatuses(t *testing.T) {
+	tests := []struct {
+		name         string
+		raw          map[string]json.RawMessage
+		wantStatuses map[string]bool
+		wantBlocking []string
+	}{
+		{"empty raw", nil, map[string]bool{}, nil},
+		{"partially complete", map[string]json.RawMessage{
+			"registration": json.RawMessage(`{"complete": true, "required": true}`),
+			"scheduling":   json.RawMessage(`{"complete": false, "required": true}`),
+			"finalPayment": json.RawMessage(`{"complete": false, "required": true}`),
+			"tradeIn":      json.RawMessage(`{"complete": false, "required": false}`),
+			"state":        json.RawMessage(`{"vinAssigned": "COMPLETE"}`),
+			"strings":      json.RawMessage(`{"title": "Tasks"}`),
+			"broken":       json.RawMessage(`"not a task"`),
+		}, map[string]bool{"registration": true, "scheduling": false, "finalPayment": false, "tradeIn": false}, []string{"finalPayment", "scheduling"}},
+		{"all complete", map[string]json.RawMessage{
+			"registration": json.RawMessage(`{"complete": true, "required": true}`),
+			"scheduling":   json.RawMessage(`{"complete": true, "required": true}`),
+		}, map[string]bool{"registration": true, "scheduling": true}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{Raw: tt.raw}}}
+			if got := order.GetAllTaskStatuses(); !reflect.DeepEqual(got, tt.wantStatuses) {
+				t.Errorf("GetAllTaskStatuses() = %v, want %v", got, tt.wantStatuses)
+			}
+			if got := order.GetBlockingTasks(); !reflect.DeepEqual(got, tt.wantBlocking) {
+				t.Errorf("GetBlockingTasks() = %v, want %v", got, tt.wantBlocking)
+			}
+		})
+	}
+}
+
+func TestCombinedOrder_GetTaskState(t *testing.T) {
+	t.Run("from RawJSON", func(t *testing.T) {
+		var rawJSON map[string]interface{}
+		if err := json.Unmarshal([]byte(`{"tasks": {"state": {"vinAssigned": "COMPLETE", "in_transit": "PENDING", "delivered": false, "meta": {"x": 1}}}}`), &rawJSON); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v", err)
+		}
+		order := CombinedOrder{Details: OrderDetails{RawJSON: rawJSON}}
+
+		state := order.GetTaskState()
+		if state == nil {
+			t.Fatal("GetTaskState() = nil, want state")
+		}
+		want := map[string]string{"vinAssigned": "COMPLETE", "in_transit": "PENDING", "delivered": "false"}
+		if len(state.Stages) != len(want) {
+			t.Errorf("Stages = %v, want %v", state.Stages, want)
+		}
+		for k, v := range want {
+			if state.Stages[k] != v {
+				t.Errorf("Stages[%q] = %q, want %q", k, state.Stages[k], v)
+			}
+		}
+	})
+
+	t.Run("from raw tasks", func(t *testing.T) {
+		order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+			Raw: map[string]json.RawMessage{"state": json.RawMessage(`{"readyForDelivery": "done"}`)},
+		}}}
+		if got := order.GetTaskState().String(); got != "readyForDelivery: done" {
+			t.Errorf("GetTaskState().String() = %q, want %q", got, "readyForDelivery: done")
+		}
+	})
+
+	t.Run("typed preferred", func(t *testing.T) {
+		order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+			State: &TaskState{Stages: map[string]string{"delivered": "complete"}},
+			Raw:   map[string]json.RawMessage{"state": json.RawMessage(`{"delivered": "pending"}`)},
+		}}}
+		if got := order.GetTaskState().Stages["delivered"]; got != "complete" {
+			t.Errorf("Stages[delivered] = %q, want complete", got)
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		order := CombinedOrder{}
+		if state := order.GetTaskState(); state != nil {
+			t.Errorf("GetTaskState() = %v, want nil", state)
+		}
+		if got := order.GetTaskState().String(); got != "N/A" {
+			t.Errorf("String() = %q, want N/A", got)
+		}
+	})
+}
+
+func TestTaskState_StageComplete(t *testing.T) {
+	state := &TaskState{Stages: map[string]string{
+		"vin_assigned":     "COMPLETED",
+		"inTransit":        "IN_PROGRESS",
+		"readyForDelivery": "true",
+	}}
+
+	tests := []struct {
+		stage        string
+		wantComplete bool
+		wantKnown    bool
+	}{
+		{StageVINAssigned, true, true},
+		{StageInTransit, false, true},
+		{StageReadyForDelivery, true, true},
+		{StageDelivered, false, false},
+	}
+	for _, tt := range tests {
+		complete, known := state.StageComplete(tt.stage)
+		if complete != tt.wantComplete || known != tt.wantKnown {
+			t.Errorf("StageComplete(%q) = %v, %v; want %v, %v", tt.stage, complete, known, tt.wantComplete, tt.wantKnown)
+		}
+	}
+
+	t.Run("unrecognised values", func(t *testing.T) {
+		state := &TaskState{Stages: map[string]string{"delivered": "2026-06-01", "inTransit": "yes"}}
+		for _, stage := range []string{StageDelivered, StageInTransit} {
+			if _, known := state.StageComplete(stage); known {
+				t.Errorf("StageComplete(%q) should not be known for an unrecognised value", stage)
+			}
+		}
+	})
+
+	t.Run("bare aliases", func(t *testing.T) {
+		state := &TaskState{Stages: map[string]string{"vin": "true", "ready": "true"}}
+		for _, stage := range []string{StageVINAssigned, StageReadyForDelivery} {
+			if _, known := state.StageComplete(stage); known {
+				t.Errorf("StageComplete(%q) should not match a bare alias", stage)
+			}
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		// Both keys describe the same stage; the preferred key wins every time
+		state := &TaskState{Stages: map[string]string{
+			"shipping":   "COMPLETED",
+			"in_transit": "PENDING",
+			"transit":    "DONE",
+		}}
+		for i := 0; i < 50; i++ {
+			if complete, known := state.StageComplete(StageInTransit); complete || !known {
+				t.Fatalf("StageComplete(%q) = %v, %v; want false, true", StageInTransit, complete, known)
+			}
+		}
+	})
+
+	var nilState *TaskState
+	if _, known := nilState.StageComplete(StageVINAssigned); known {
+		t.Error("nil state should not know any stage")
+	}
+}
+
+func TestTaskState_PersistsInSnapshot(t *testing.T) {
+	order := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		State: &TaskState{Stages: map[string]string{"delivered": "complete"}},
+	}}}
+
+	data, err := json.Marshal(order)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var restored CombinedOrder
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got := restored.GetTaskState().String(); got != "delivered: complete" {
+		t.Errorf("restored state = %q, want %q", got, "delivered: complete")
+	}
+}
+
+func TestCompareOrders_TaskState(t *testing.T) {
+	oldOrder := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		State: &TaskState{Stages: map[string]string{"inTransit": "pending"}},
+	}}}
+	newOrder := CombinedOrder{Details: OrderDetails{Tasks: OrderTasks{
+		State: &TaskState{Stages: map[string]string{"inTransit": "complete"}},
+	}}}
+
+	diffs := CompareOrders(oldOrder, newOrder)
+	if len(diffs) != 1 || diffs[0].Field != "Task State" {
+		t.Fatalf("CompareOrders() = %v, want one Task State diff", diffs)
+	}
+	if diffs[0].OldValue != "inTransit: pending" || diffs[0].NewValue != "inTransit: complete" {
+		t.Errorf("diff = %v → %v", diffs[0].OldValue, diffs[0].NewValue)
+	}
+}
+
+func TestTeslaTask_GetActionURL(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"button target", `{"card": {"buttonText": {"cta": "Pay", "target": "https://www.tesla.com/pay"}}}`, "https://www.tesla.com/pay"},
+		{"button without target", `{"card": {"buttonText": {"cta": "Pay"}}}`, ""},
+		{"card without button", `{"card": {"title": "Pay"}}`, ""},
+		{"no card", `{"complete": true}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var task TeslaTask
+			if err := json.Unmarshal([]byte(tt.json), &task); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got := task.GetActionURL(); got != tt.want {
+				t.Errorf("GetActionURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
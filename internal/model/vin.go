@@ -1,6 +1,9 @@
 package model
 
-import "strings"
+import (
+	"strings"
+	"sync"
+)
 
 // VINInfo contains decoded VIN information
 type VINInfo struct {
@@ -14,6 +17,7 @@ type VINInfo struct {
 	ModelYear         string
 	ManufacturingPlant string
 	SerialNumber      string
+	CheckDigitValid   bool
 }
 
 // World Manufacturer Identifier (first 3 characters)
@@ -64,6 +68,7 @@ var bodyTypeMap = map[string]map[byte]string{
 	"C": {
 		'A': "Pickup, LHD",
 		'B': "Pickup, RHD",
+		'E': "Pickup 4-door, LHD", // production VINs: 7G2CEHE...
 	},
 }
 
@@ -145,7 +150,27 @@ var plantMap = map[byte]string{
 	'N': "Reno, NV, USA",
 }
 
-// DecodeVIN decodes a Tesla VIN into its component parts
+// vinCache holds decoded VINs keyed by the normalized VIN. VINs don't change
+// during a session, so entries are never evicted.
+var vinCache sync.Map
+
+// ClearVINCache empties the VIN decode cache
+func ClearVINCache() {
+	vinCache.Clear()
+}
+
+// IsRHD reports whether the body type is right-hand drive
+func (v *VINInfo) IsRHD() bool {
+	return strings.Contains(v.BodyType, "RHD")
+}
+
+// IsLHD reports whether the body type is left-hand drive
+func (v *VINInfo) IsLHD() bool {
+	return strings.Contains(v.BodyType, "LHD")
+}
+
+// DecodeVIN decodes a Tesla VIN into its component parts. Results are cached;
+// each call returns a copy so callers can't modify the cached value.
 func DecodeVIN(vin string) *VINInfo {
 	vin = strings.ToUpper(strings.TrimSpace(vin))
 
@@ -153,6 +178,18 @@ func DecodeVIN(vin string) *VINInfo {
 		return nil
 	}
 
+	if cached, ok := vinCache.Load(vin); ok {
+		info := cached.(VINInfo)
+		return &info
+	}
+
+	info := decodeVIN(vin)
+	vinCache.Store(vin, *info)
+	return info
+}
+
+// decodeVIN decodes a normalized 17-character VIN without using the cache
+func decodeVIN(vin string) *VINInfo {
 	info := &VINInfo{
 		VIN: vin,
 	}
@@ -240,5 +277,57 @@ func DecodeVIN(vin string) *VINInfo {
 	// Serial number (chars 12-17)
 	info.SerialNumber = vin[11:17]
 
+	// Check digit (char 9)
+	info.CheckDigitValid = ValidateVINCheckDigit(vin)
+
 	return info
 }
+
+// vinWeights are the NHTSA weights for each VIN position; the check digit
+// itself (position 9) has weight 0
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinTransliteration maps VIN characters to their value for the check digit.
+// I, O and Q are not allowed in VINs.
+func vinTransliteration(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1, true
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1, true
+	case c == 'P':
+		return 7, true
+	case c == 'R':
+		return 9, true
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2, true
+	}
+	return 0, false
+}
+
+// ValidateVINCheckDigit reports whether the check digit at position 9 of a
+// VIN matches the NHTSA formula: the weighted sum of the transliterated
+// characters modulo 11, where 10 is written as X
+func ValidateVINCheckDigit(vin string) bool {
+	vin = strings.ToUpper(strings.TrimSpace(vin))
+	if len(vin) != 17 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < len(vin); i++ {
+		value, ok := vinTransliteration(vin[i])
+		if !ok {
+			return false
+		}
+		sum += value * vinWeights[i]
+	}
+
+	want := byte('0' + sum%11)
+	if sum%11 == 10 {
+		want = 'X'
+	}
+	return vin[8] == want
+}
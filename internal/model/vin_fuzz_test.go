@@ -0,0 +1,42 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func FuzzDecodeVIN(f *testing.F) {
+	seeds := []string{
+		"5YJ3E1EA1LF123456",
+		"XP7YACEF5TB123456",
+		"7SAYGDEE5PA123456",
+		"LRW3E7EK4NC123456",
+		"  5yj3e1ea1lf123456  ",
+		"",
+		"5YJ3E1EA1LF12345",
+		"5YJ3E1EA1LF1234567",
+		"ÄÖÜ3E1EA1LF123456",
+		"5YJ3E1EA1LF12345\xff",
+		"\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",
+		"ſſſſſſſſſſſſſſſſſ",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, vin string) {
+		info := DecodeVIN(vin)
+		if info == nil {
+			return
+		}
+		if len(info.VIN) != 17 {
+			t.Errorf("DecodeVIN(%q) returned VIN of length %d", vin, len(info.VIN))
+		}
+		if info.VIN != strings.ToUpper(strings.TrimSpace(vin)) {
+			t.Errorf("DecodeVIN(%q).VIN = %q, want normalized input", vin, info.VIN)
+		}
+		if len(info.SerialNumber) != 6 {
+			t.Errorf("DecodeVIN(%q).SerialNumber = %q, want 6 characters", vin, info.SerialNumber)
+		}
+	})
+}
@@ -45,10 +45,10 @@ func TestDecodeVIN(t *testing.T) {
 		},
 		{
 			name:    "Model Y from Berlin",
-			vin:     "XP7YACEF9TB123456",
+			vin:     "XP7YACEF5TB123456",
 			wantNil: false,
 			expected: &VINInfo{
-				VIN:                "XP7YACEF9TB123456",
+				VIN:                "XP7YACEF5TB123456",
 				Manufacturer:       "Tesla, Inc.",
 				ManufactureRegion:  "Berlin, Germany",
 				Model:              "Model Y",
@@ -321,3 +321,107 @@ func TestDecodeVIN_AllModels(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeVIN_Cache(t *testing.T) {
+	ClearVINCache()
+	defer ClearVINCache()
+
+	first := DecodeVIN(" 5yj3e1ea1lf123456 ")
+	if first == nil {
+		t.Fatal("DecodeVIN() returned nil")
+	}
+	if _, ok := vinCache.Load("5YJ3E1EA1LF123456"); !ok {
+		t.Fatal("DecodeVIN() did not cache the normalized VIN")
+	}
+
+	// Mutating a returned value must not leak into the cache
+	first.Model = "Changed"
+	second := DecodeVIN("5YJ3E1EA1LF123456")
+	if second.Model != "Model 3" {
+		t.Errorf("cached Model = %q, want %q", second.Model, "Model 3")
+	}
+
+	ClearVINCache()
+	if _, ok := vinCache.Load("5YJ3E1EA1LF123456"); ok {
+		t.Error("ClearVINCache() did not remove cached entries")
+	}
+}
+
+func TestValidateVINCheckDigit(t *testing.T) {
+	tests := []struct {
+		name string
+		vin  string
+		want bool
+	}{
+		{"valid Model Y", "7SAYGDEE1PA123456", true},
+		{"valid lowercase", "7saygdee1pa123456", true},
+		{"valid X check digit", "1M8GDM9AXKP042788", true},
+		{"valid all ones", "11111111111111111", true},
+		{"invalid Model 3", "5YJ3E1EA1LF123456", false},
+		{"invalid Model Y", "7SAYGDEE5PA123456", false},
+		{"valid Berlin", "XP7YACEF5TB123456", true},
+		{"invalid Berlin", "XP7YACEF9TB123456", false},
+		{"letter I not allowed", "7SAYGDEE1PA12345I", false},
+		{"too short", "7SAYGDEE1PA12345", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValidateVINCheckDigit(tt.vin); got != tt.want {
+				t.Errorf("ValidateVINCheckDigit(%q) = %v, want %v", tt.vin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeVIN_CheckDigit(t *testing.T) {
+	if info := DecodeVIN("7SAYGDEE1PA123456"); !info.CheckDigitValid {
+		t.Error("CheckDigitValid = false for a VIN with a correct check digit")
+	}
+	if info := DecodeVIN("7SAYGDEE5PA123456"); info.CheckDigitValid {
+		t.Error("CheckDigitValid = true for a VIN with a wrong check digit")
+	}
+}
+
+func TestVINInfo_IsRHD(t *testing.T) {
+	tests := []struct {
+		vin     string
+		wantRHD bool
+		wantLHD bool
+	}{
+		{"5YJ3A1EA1LF123456", false, true},
+		{"5YJ3B1EA1LF123456", true, false},
+		{"5YJ3Z1EA1LF123456", false, false}, // unknown body type
+	}
+	for _, tt := range tests {
+		info := DecodeVIN(tt.vin)
+		if info == nil {
+			t.Fatalf("DecodeVIN(%q) = nil", tt.vin)
+		}
+		if got := info.IsRHD(); got != tt.wantRHD {
+			t.Errorf("DecodeVIN(%q).IsRHD() = %v, want %v (body type %q)", tt.vin, got, tt.wantRHD, info.BodyType)
+		}
+		if got := info.IsLHD(); got != tt.wantLHD {
+			t.Errorf("DecodeVIN(%q).IsLHD() = %v, want %v (body type %q)", tt.vin, got, tt.wantLHD, info.BodyType)
+		}
+	}
+}
+
+func BenchmarkDecodeVIN(b *testing.B) {
+	const vin = "5YJ3E1EA1LF123456"
+
+	b.Run("uncached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			decodeVIN(vin)
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		ClearVINCache()
+		defer ClearVINCache()
+		for i := 0; i < b.N; i++ {
+			DecodeVIN(vin)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+const acksDirName = "acks"
+
+// AcknowledgementState stores detected changes for an order until they are dismissed
+type AcknowledgementState struct {
+	ReferenceNumber string            `json:"referenceNumber"`
+	Pending         []model.OrderDiff `json:"pending"`
+	Seen            map[string]string `json:"seen"` // field -> acknowledged value
+}
+
+// Acknowledgements manages persistence of seen change notifications
+type Acknowledgements struct {
+	baseDir string
+}
+
+// NewAcknowledgements creates a new Acknowledgements instance
+func NewAcknowledgements(configDir string) (*Acknowledgements, error) {
+	acksDir := filepath.Join(configDir, acksDirName)
+	if err := os.MkdirAll(acksDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create acknowledgements directory: %w", err)
+	}
+
+	return &Acknowledgements{baseDir: acksDir}, nil
+}
+
+func (a *Acknowledgements) filePath(referenceNumber string) string {
+	return filepath.Join(a.baseDir, referenceNumber+".json")
+}
+
+// LoadState loads the acknowledgement state for a specific order
+func (a *Acknowledgements) LoadState(referenceNumber string) (*AcknowledgementState, error) {
+	data, err := os.ReadFile(a.filePath(referenceNumber))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &AcknowledgementState{
+				ReferenceNumber: referenceNumber,
+				Seen:            make(map[string]string),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to read acknowledgements file: %w", err)
+	}
+
+	var state AcknowledgementState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse acknowledgements file: %w", err)
+	}
+	if state.Seen == nil {
+		state.Seen = make(map[string]string)
+	}
+
+	return &state, nil
+}
+
+// SaveState saves the acknowledgement state for a specific order
+func (a *Acknowledgements) SaveState(state *AcknowledgementState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal acknowledgements: %w", err)
+	}
+
+	if err := os.WriteFile(a.filePath(state.ReferenceNumber), data, 0600); err != nil {
+		return fmt.Errorf("failed to write acknowledgements file: %w", err)
+	}
+
+	return nil
+}
+
+// Record merges newly detected changes into the pending list and returns all
+// changes that have not been acknowledged yet. Changes to a value that was
+// already acknowledged are ignored.
+func (a *Acknowledgements) Record(referenceNumber string, diffs []model.OrderDiff) ([]model.OrderDiff, error) {
+	state, err := a.LoadState(referenceNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(diffs) == 0 {
+		return state.Pending, nil
+	}
+
+	for _, diff := range diffs {
+		if seen, ok := state.Seen[diff.Field]; ok && seen == fmt.Sprint(diff.NewValue) {
+			continue
+		}
+
+		replaced := false
+		for i := range state.Pending {
+			if state.Pending[i].Field == diff.Field {
+				// Keep the original old value so the diff spans all unseen changes
+				state.Pending[i].NewValue = diff.NewValue
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			state.Pending = append(state.Pending, diff)
+		}
+	}
+
+	if err := a.SaveState(state); err != nil {
+		return nil, err
+	}
+
+	return state.Pending, nil
+}
+
+// Unacknowledged returns the pending changes for an order
+func (a *Acknowledgements) Unacknowledged(referenceNumber string) ([]model.OrderDiff, error) {
+	state, err := a.LoadState(referenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	return state.Pending, nil
+}
+
+// AcknowledgeAll marks all pending changes for an order as seen
+func (a *Acknowledgements) AcknowledgeAll(referenceNumber string) error {
+	state, err := a.LoadState(referenceNumber)
+	if err != nil {
+		return err
+	}
+
+	for _, diff := range state.Pending {
+		state.Seen[diff.Field] = fmt.Sprint(diff.NewValue)
+	}
+	state.Pending = nil
+
+	return a.SaveState(state)
+}
+
+// AcknowledgeField marks the pending change to a single field of an order as
+// seen, leaving the other pending changes in place
+func (a *Acknowledgements) AcknowledgeField(referenceNumber, field string) error {
+	state, err := a.LoadState(referenceNumber)
+	if err != nil {
+		return err
+	}
+
+	pending := state.Pending[:0]
+	for _, diff := range state.Pending {
+		if diff.Field == field {
+			state.Seen[diff.Field] = fmt.Sprint(diff.NewValue)
+			continue
+		}
+		pending = append(pending, diff)
+	}
+	state.Pending = pending
+	if len(state.Pending) == 0 {
+		state.Pending = nil
+	}
+
+	return a.SaveState(state)
+}
@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func TestNewAcknowledgements(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, err := NewAcknowledgements(tempDir)
+	if err != nil {
+		t.Fatalf("NewAcknowledgements() error = %v", err)
+	}
+	if acks == nil {
+		t.Fatal("NewAcknowledgements() returned nil")
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "acks")); err != nil {
+		t.Errorf("acks directory not created: %v", err)
+	}
+}
+
+func TestAcknowledgements_PendingPersistsAcrossInstances(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, _ := NewAcknowledgements(tempDir)
+	diffs := []model.OrderDiff{
+		{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"},
+	}
+	if _, err := acks.Record("RN123456789", diffs); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	// A new session sees no fresh diffs but the change is still unacknowledged
+	reloaded, _ := NewAcknowledgements(tempDir)
+	pending, err := reloaded.Record("RN123456789", nil)
+	if err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].Field != "Order Status" {
+		t.Errorf("pending = %v, want the Order Status change", pending)
+	}
+}
+
+func TestAcknowledgements_AcknowledgeAll(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, _ := NewAcknowledgements(tempDir)
+	diffs := []model.OrderDiff{
+		{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"},
+		{Field: "VIN", OldValue: "N/A", NewValue: "5YJ3E7EB2NF123456"},
+	}
+	if _, err := acks.Record("RN123456789", diffs); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if err := acks.AcknowledgeAll("RN123456789"); err != nil {
+		t.Fatalf("AcknowledgeAll() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(tempDir, "acks", "RN123456789.json")); err != nil {
+		t.Errorf("acks file not written: %v", err)
+	}
+
+	reloaded, _ := NewAcknowledgements(tempDir)
+	pending, err := reloaded.Unacknowledged("RN123456789")
+	if err != nil {
+		t.Fatalf("Unacknowledged() error = %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("pending = %v, want none after AcknowledgeAll", pending)
+	}
+
+	// Seeing the same change again must not bring the badge back
+	pending, _ = reloaded.Record("RN123456789", diffs[:1])
+	if len(pending) != 0 {
+		t.Errorf("pending = %v, want acknowledged change to stay hidden", pending)
+	}
+
+	// A new value for the same field is unacknowledged again
+	pending, _ = reloaded.Record("RN123456789", []model.OrderDiff{
+		{Field: "Order Status", OldValue: "IN_PROGRESS", NewValue: "DELIVERED"},
+	})
+	if len(pending) != 1 {
+		t.Errorf("pending = %v, want the new Order Status change", pending)
+	}
+}
+
+func TestAcknowledgements_RecordMergesSameField(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, _ := NewAcknowledgements(tempDir)
+	acks.Record("RN123456789", []model.OrderDiff{{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"}})
+	pending, err := acks.Record("RN123456789", []model.OrderDiff{{Field: "Order Status", OldValue: "IN_PROGRESS", NewValue: "DELIVERED"}})
+	if err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if len(pending) != 1 {
+		t.Fatalf("pending = %v, want a single merged change", pending)
+	}
+	if pending[0].OldValue != "BOOKED" || pending[0].NewValue != "DELIVERED" {
+		t.Errorf("pending[0] = %+v, want BOOKED -> DELIVERED", pending[0])
+	}
+}
+
+func TestAcknowledgements_AcknowledgeField(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, _ := NewAcknowledgements(tempDir)
+	diffs := []model.OrderDiff{
+		{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"},
+		{Field: "VIN", OldValue: "N/A", NewValue: "XP7YACEF5TB123456"},
+	}
+	if _, err := acks.Record("RN123456789", diffs); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	if err := acks.AcknowledgeField("RN123456789", "VIN"); err != nil {
+		t.Fatalf("AcknowledgeField() error = %v", err)
+	}
+
+	reloaded, _ := NewAcknowledgements(tempDir)
+	pending, err := reloaded.Unacknowledged("RN123456789")
+	if err != nil {
+		t.Fatalf("Unacknowledged() error = %v", err)
+	}
+	if len(pending) != 1 || pending[0].Field != "Order Status" {
+		t.Errorf("pending = %v, want only the Order Status change", pending)
+	}
+
+	// The acknowledged value is not reported again
+	pending, _ = reloaded.Record("RN123456789", diffs[1:])
+	if len(pending) != 1 {
+		t.Errorf("pending after re-recording the seen VIN = %v, want 1 change", pending)
+	}
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const archiveFileName = "archived.json"
+
+// Archive manages the set of archived (hidden) order reference numbers
+type Archive struct {
+	refs referenceSet
+}
+
+// NewArchive creates a new Archive instance
+func NewArchive(configDir string) (*Archive, error) {
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	return &Archive{refs: referenceSet{filePath: filepath.Join(configDir, archiveFileName), key: "archived"}}, nil
+}
+
+// Load returns the set of archived reference numbers
+func (a *Archive) Load() (map[string]bool, error) {
+	return a.refs.load()
+}
+
+// IsArchived reports whether an order is archived
+func (a *Archive) IsArchived(referenceNumber string) (bool, error) {
+	archived, err := a.refs.load()
+	if err != nil {
+		return false, err
+	}
+	return archived[referenceNumber], nil
+}
+
+// Toggle archives or unarchives an order and returns the new state
+func (a *Archive) Toggle(referenceNumber string) (bool, error) {
+	return a.refs.toggle(referenceNumber)
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchive_ToggleAndPersist(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	archive, err := NewArchive(tempDir)
+	if err != nil {
+		t.Fatalf("NewArchive() error = %v", err)
+	}
+
+	archived, err := archive.Toggle("RN123456789")
+	if err != nil {
+		t.Fatalf("Toggle() error = %v", err)
+	}
+	if !archived {
+		t.Error("Toggle() = false, want true after first toggle")
+	}
+
+	// A fresh instance sees the persisted state
+	reloaded, _ := NewArchive(tempDir)
+	if ok, err := reloaded.IsArchived("RN123456789"); err != nil || !ok {
+		t.Errorf("IsArchived() = %v, %v; want true, nil", ok, err)
+	}
+	if ok, _ := reloaded.IsArchived("RN987654321"); ok {
+		t.Error("IsArchived() = true for an order that was never archived")
+	}
+
+	archived, err = reloaded.Toggle("RN123456789")
+	if err != nil {
+		t.Fatalf("Toggle() error = %v", err)
+	}
+	if archived {
+		t.Error("Toggle() = true, want false after second toggle")
+	}
+	if ok, _ := archive.IsArchived("RN123456789"); ok {
+		t.Error("order should be unarchived after toggling twice")
+	}
+}
+
+func TestArchive_Load_NoFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	archive, _ := NewArchive(tempDir)
+	archived, err := archive.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(archived) != 0 {
+		t.Errorf("Load() = %v, want empty", archived)
+	}
+}
+
+func TestArchive_Load_InvalidFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	if err := os.WriteFile(filepath.Join(tempDir, archiveFileName), []byte("not json"), 0600); err != nil {
+		t.Fatalf("Failed to write archive file: %v", err)
+	}
+
+	archive, _ := NewArchive(tempDir)
+	if _, err := archive.Load(); err == nil {
+		t.Error("Load() should fail on an invalid archive file")
+	}
+}
@@ -5,21 +5,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
 )
 
 const checklistDirName = "checklists"
 
 // ChecklistItem represents a single checklist item
-type ChecklistItem struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
-}
+type ChecklistItem = model.ChecklistItem
 
 // ChecklistSection represents a group of checklist items
-type ChecklistSection struct {
-	Title string          `json:"title"`
-	Items []ChecklistItem `json:"items"`
-}
+type ChecklistSection = model.ChecklistSection
 
 // ChecklistState stores which items have been checked per order
 type ChecklistState struct {
@@ -44,6 +40,64 @@ var DeliveryChecklist = []ChecklistSection{
 			{ID: "pickup_route", Text: "Route to delivery center or pickup location planned"},
 		},
 	},
+	{
+		Title: "Exterior Inspection",
+		Items: []ChecklistItem{
+			{ID: "ext_panel_gaps", Text: "Panel gaps even and consistent on both sides"},
+			{ID: "ext_paint_chips", Text: "Paint free of deep scratches, chips and swirl marks"},
+			{ID: "ext_glass", Text: "Windshield, windows and roof glass free of chips or cracks"},
+			{ID: "ext_door_seals", Text: "Door and window seals properly seated"},
+			{ID: "ext_frunk_trunk", Text: "Frunk and trunk close flush and latch properly"},
+			{ID: "ext_charge_port", Text: "Charge port door opens and closes"},
+			{ID: "ext_lights", Text: "Headlights, tail lights, indicators and fog lights functional"},
+			{ID: "ext_wheel_liners", Text: "Wheel well liners secured"},
+			{ID: "ext_vin_plate", Text: "VIN plate matches the purchase paperwork"},
+			{ID: "ext_tire_tread", Text: "Tires have full tread and no sidewall damage; wheels free of curb rash"},
+		},
+	},
+	{
+		Title: "Interior Inspection",
+		Items: []ChecklistItem{
+			{ID: "int_touchscreen", Text: "Touchscreen responsive, no dead spots or flicker"},
+			{ID: "int_buttons", Text: "All buttons and switches functional (windows, door releases, glovebox)"},
+			{ID: "int_climate", Text: "AC and heat work in all zones, including rear vents"},
+			{ID: "int_seats", Text: "Seat adjustments, heating and memory positions work"},
+			{ID: "int_steering_controls", Text: "Steering wheel scroll wheels and buttons respond"},
+			{ID: "int_cameras", Text: "Rearview and side repeater cameras clear on screen"},
+			{ID: "int_sun_visors", Text: "Sun visors in good condition and stay in place"},
+			{ID: "int_headliner", Text: "Headliner free of stains, sags or marks"},
+			{ID: "int_seatbelts", Text: "All seatbelts latch, release and retract"},
+			{ID: "int_floor_mats", Text: "Floor mats present and correct for the model"},
+		},
+	},
+	{
+		Title: "Electronics & Software",
+		Items: []ChecklistItem{
+			{ID: "elec_software_version", Text: "Software version noted (Controls > Software)"},
+			{ID: "elec_autopilot_features", Text: "Purchased FSD/Autopilot options shown under car features"},
+			{ID: "elec_bluetooth", Text: "Phone pairs over Bluetooth and plays audio"},
+			{ID: "elec_ota_update", Text: "Over-the-air update check runs and downloads over Wi-Fi"},
+			{ID: "elec_homelink", Text: "HomeLink garage door opener pairs (if equipped)"},
+			{ID: "elec_dashcam", Text: "Dashcam records to the USB drive"},
+			{ID: "elec_usb_ports", Text: "Front and rear USB ports charge devices"},
+			{ID: "elec_rear_screen", Text: "Rear screen responds (if applicable)"},
+			{ID: "elec_keys", Text: "Key cards and phone key set up and working"},
+		},
+	},
+	{
+		Title: "Documentation",
+		Items: []ChecklistItem{
+			{ID: "doc_mvpa_copy", Text: "Signed copy of the Motor Vehicle Purchase Agreement received"},
+			{ID: "doc_window_sticker", Text: "Window sticker saved or downloaded"},
+			{ID: "doc_registration", Text: "Registration in progress (if driving on dealer plates)"},
+			{ID: "doc_title_transfer", Text: "Title transfer started"},
+			{ID: "doc_tax_credit", Text: "EV tax credit form available (if eligible)"},
+			{ID: "doc_delivery_receipt", Text: "Delivery receipt signed"},
+			{ID: "doc_charge_cable", Text: "Mobile charge cable included"},
+			{ID: "doc_floor_mat_bag", Text: "Floor mat bag included"},
+			{ID: "doc_key_cards", Text: "Two key cards present"},
+		},
+	},
 }
 
 // Checklist manages checklist persistence
@@ -120,11 +174,33 @@ func (c *Checklist) ToggleItem(referenceNumber, itemID string) (bool, error) {
 	return newValue, nil
 }
 
+// ResetState clears all checked items for a specific order
+func (c *Checklist) ResetState(referenceNumber string) error {
+	return c.SaveState(&ChecklistState{
+		ReferenceNumber: referenceNumber,
+		Checked:         make(map[string]bool),
+	})
+}
+
+// ChecklistFor returns the delivery checklist for a model code: the standard
+// DeliveryChecklist followed by any model-specific sections
+func ChecklistFor(modelCode string) []ChecklistSection {
+	extra := model.ModelSpecificChecklist(modelCode)
+	sections := make([]ChecklistSection, 0, len(DeliveryChecklist)+len(extra))
+	sections = append(sections, DeliveryChecklist...)
+	return append(sections, extra...)
+}
+
 // CountCompleted returns (completed, total) counts for all checklist items
 func CountCompleted(checked map[string]bool) (int, int) {
+	return CountCompletedIn(DeliveryChecklist, checked)
+}
+
+// CountCompletedIn returns (completed, total) counts for the items in sections
+func CountCompletedIn(sections []ChecklistSection, checked map[string]bool) (int, int) {
 	total := 0
 	completed := 0
-	for _, section := range DeliveryChecklist {
+	for _, section := range sections {
 		for _, item := range section.Items {
 			total++
 			if checked[item.ID] {
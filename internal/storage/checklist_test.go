@@ -2,6 +2,7 @@ package storage
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -152,6 +153,68 @@ func TestChecklist_ToggleItem_MultipleItems(t *testing.T) {
 	}
 }
 
+func TestChecklist_ResetState(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-checklist-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cl, _ := NewChecklist(tempDir)
+
+	cl.ToggleItem("RN123", "finance_sorted")
+	cl.ToggleItem("RN123", "insured")
+
+	if err := cl.ResetState("RN123"); err != nil {
+		t.Fatalf("ResetState() error = %v", err)
+	}
+
+	// Verify persisted as empty
+	state, err := cl.LoadState("RN123")
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if state.ReferenceNumber != "RN123" {
+		t.Errorf("ReferenceNumber = %q, want %q", state.ReferenceNumber, "RN123")
+	}
+	if len(state.Checked) != 0 {
+		t.Errorf("Checked should be empty after reset, got %d items", len(state.Checked))
+	}
+
+	// A fresh instance should see the reset state too
+	cl2, _ := NewChecklist(tempDir)
+	state, _ = cl2.LoadState("RN123")
+	if len(state.Checked) != 0 {
+		t.Errorf("Reset state not persisted, got %d items", len(state.Checked))
+	}
+}
+
+func TestChecklist_ResetState_Empty(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-checklist-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cl, _ := NewChecklist(tempDir)
+
+	// Resetting an order without saved state should succeed
+	if err := cl.ResetState("RN999"); err != nil {
+		t.Fatalf("ResetState() error = %v", err)
+	}
+
+	state, err := cl.LoadState("RN999")
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if state.Checked == nil {
+		t.Error("Checked map should not be nil")
+	}
+	if len(state.Checked) != 0 {
+		t.Errorf("Checked should be empty, got %d items", len(state.Checked))
+	}
+}
+
 func TestChecklist_SeparateOrders(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "tesla-tui-checklist-*")
 	if err != nil {
@@ -268,6 +331,153 @@ func TestDeliveryChecklist_Structure(t *testing.T) {
 	}
 }
 
+// checklistSection returns the DeliveryChecklist section with the given title
+func checklistSection(t *testing.T, title string) ChecklistSection {
+	t.Helper()
+	for _, section := range DeliveryChecklist {
+		if section.Title == title {
+			return section
+		}
+	}
+	t.Fatalf("DeliveryChecklist has no %q section", title)
+	return ChecklistSection{}
+}
+
+func TestDeliveryChecklist_ExteriorInspection(t *testing.T) {
+	section := checklistSection(t, "Exterior Inspection")
+
+	wantIDs := []string{
+		"ext_panel_gaps", "ext_paint_chips", "ext_glass", "ext_door_seals", "ext_frunk_trunk",
+		"ext_charge_port", "ext_lights", "ext_wheel_liners", "ext_vin_plate", "ext_tire_tread",
+	}
+	if len(section.Items) != len(wantIDs) {
+		t.Fatalf("Exterior Inspection has %d items, want %d", len(section.Items), len(wantIDs))
+	}
+	for i, want := range wantIDs {
+		if section.Items[i].ID != want {
+			t.Errorf("item %d ID = %q, want %q", i, section.Items[i].ID, want)
+		}
+	}
+}
+
+func TestDeliveryChecklist_InteriorInspection(t *testing.T) {
+	section := checklistSection(t, "Interior Inspection")
+
+	if len(section.Items) != 10 {
+		t.Errorf("Interior Inspection has %d items, want 10", len(section.Items))
+	}
+	for _, item := range section.Items {
+		if !strings.HasPrefix(item.ID, "int_") {
+			t.Errorf("item ID %q should start with \"int_\"", item.ID)
+		}
+	}
+
+	// Checking every interior item counts towards the overall progress
+	checked := make(map[string]bool)
+	for _, item := range section.Items {
+		checked[item.ID] = true
+	}
+	if completed, _ := CountCompleted(checked); completed != len(section.Items) {
+		t.Errorf("CountCompleted() completed = %d, want %d", completed, len(section.Items))
+	}
+}
+
+func TestDeliveryChecklist_ElectronicsAndSoftware(t *testing.T) {
+	section := checklistSection(t, "Electronics & Software")
+
+	if len(section.Items) != 9 {
+		t.Errorf("Electronics & Software has %d items, want 9", len(section.Items))
+	}
+	seen := make(map[string]bool)
+	for _, item := range section.Items {
+		if !strings.HasPrefix(item.ID, "elec_") {
+			t.Errorf("item ID %q should start with \"elec_\"", item.ID)
+		}
+		if seen[item.ID] {
+			t.Errorf("duplicate item ID %q", item.ID)
+		}
+		seen[item.ID] = true
+	}
+
+	// The section adds its items to the overall total
+	_, total := CountCompleted(nil)
+	without := 0
+	for _, other := range DeliveryChecklist {
+		if other.Title != section.Title {
+			without += len(other.Items)
+		}
+	}
+	if total != without+len(section.Items) {
+		t.Errorf("CountCompleted() total = %d, want %d", total, without+len(section.Items))
+	}
+}
+
+func TestDeliveryChecklist_Sections(t *testing.T) {
+	wantTitles := []string{"Before Delivery", "Exterior Inspection", "Interior Inspection", "Electronics & Software", "Documentation"}
+	if len(DeliveryChecklist) != len(wantTitles) {
+		t.Fatalf("DeliveryChecklist has %d sections, want %d", len(DeliveryChecklist), len(wantTitles))
+	}
+	for i, want := range wantTitles {
+		if DeliveryChecklist[i].Title != want {
+			t.Errorf("section %d title = %q, want %q", i, DeliveryChecklist[i].Title, want)
+		}
+	}
+
+	section := checklistSection(t, "Documentation")
+	if len(section.Items) != 9 {
+		t.Errorf("Documentation has %d items, want 9", len(section.Items))
+	}
+	for _, item := range section.Items {
+		if !strings.HasPrefix(item.ID, "doc_") {
+			t.Errorf("item ID %q should start with \"doc_\"", item.ID)
+		}
+	}
+}
+
+func TestChecklistFor(t *testing.T) {
+	_, baseTotal := CountCompleted(nil)
+
+	tests := []struct {
+		modelCode string
+		wantExtra int
+	}{
+		{"my", 0},
+		{"m3", 0},
+		{"ms", 0},
+		{"mx", 4},
+		{"ct", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelCode, func(t *testing.T) {
+			sections := ChecklistFor(tt.modelCode)
+			_, total := CountCompletedIn(sections, nil)
+			if total != baseTotal+tt.wantExtra {
+				t.Errorf("ChecklistFor(%q) has %d items, want %d", tt.modelCode, total, baseTotal+tt.wantExtra)
+			}
+
+			seen := map[string]bool{}
+			for _, section := range sections {
+				for _, item := range section.Items {
+					if seen[item.ID] {
+						t.Errorf("duplicate item ID %q", item.ID)
+					}
+					seen[item.ID] = true
+				}
+			}
+		})
+	}
+}
+
+func TestChecklistFor_DoesNotModifyBase(t *testing.T) {
+	baseSections := len(DeliveryChecklist)
+	ChecklistFor("mx")
+	ChecklistFor("ct")
+	if len(DeliveryChecklist) != baseSections {
+		t.Errorf("DeliveryChecklist has %d sections after ChecklistFor, want %d", len(DeliveryChecklist), baseSections)
+	}
+}
+
 func TestChecklist_FilePermissions(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "tesla-tui-checklist-*")
 	if err != nil {
@@ -2,9 +2,12 @@ package storage
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
@@ -13,11 +16,15 @@ import (
 const (
 	historyDirName       = "history"
 	maxHistoryEntries    = 20
+	// DefaultMaxHistoryAge is how long snapshots are kept before being pruned
+	DefaultMaxHistoryAge = 90 * 24 * time.Hour
 )
 
 // History manages order history persistence
 type History struct {
-	baseDir string
+	baseDir    string
+	maxEntries int
+	maxAge     time.Duration
 }
 
 // NewHistory creates a new History instance
@@ -27,7 +34,25 @@ func NewHistory(configDir string) (*History, error) {
 		return nil, fmt.Errorf("failed to create history directory: %w", err)
 	}
 
-	return &History{baseDir: historyDir}, nil
+	return &History{baseDir: historyDir, maxEntries: maxHistoryEntries, maxAge: DefaultMaxHistoryAge}, nil
+}
+
+// SetMaxEntries sets how many snapshots are kept per order.
+// Values below 1 restore the default.
+func (h *History) SetMaxEntries(n int) {
+	if n < 1 {
+		n = maxHistoryEntries
+	}
+	h.maxEntries = n
+}
+
+// SetMaxAge sets how long snapshots are kept before being pruned.
+// Values below 1 restore the default.
+func (h *History) SetMaxAge(d time.Duration) {
+	if d < 1 {
+		d = DefaultMaxHistoryAge
+	}
+	h.maxAge = d
 }
 
 // historyFilePath returns the path to the history file for an order
@@ -61,10 +86,13 @@ func (h *History) LoadHistory(referenceNumber string) (*model.OrderHistory, erro
 // SaveHistory saves the history for a specific order
 func (h *History) SaveHistory(history *model.OrderHistory) error {
 	// Prune to max entries
-	if len(history.Snapshots) > maxHistoryEntries {
-		history.Snapshots = history.Snapshots[len(history.Snapshots)-maxHistoryEntries:]
+	if len(history.Snapshots) > h.maxEntries {
+		history.Snapshots = history.Snapshots[len(history.Snapshots)-h.maxEntries:]
 	}
 
+	// Prune by age
+	history.Snapshots = pruneSnapshots(history.Snapshots, h.maxAge, time.Now())
+
 	data, err := json.MarshalIndent(history, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal history: %w", err)
@@ -122,6 +150,125 @@ func (h *History) GetLatestSnapshot(referenceNumber string) (*model.HistoricalSn
 	return &history.Snapshots[len(history.Snapshots)-1], nil
 }
 
+// DeleteHistory removes the stored history for an order
+func (h *History) DeleteHistory(referenceNumber string) error {
+	if err := os.Remove(h.historyFilePath(referenceNumber)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete history file: %w", err)
+	}
+	return nil
+}
+
+// ListAllHistories returns the stored history of every order, the most
+// recently updated first. Histories without snapshots come last.
+func (h *History) ListAllHistories() ([]*model.OrderHistory, error) {
+	entries, err := os.ReadDir(h.baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history directory: %w", err)
+	}
+
+	var histories []*model.OrderHistory
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		history, err := h.LoadHistory(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, history)
+	}
+
+	sort.SliceStable(histories, func(i, j int) bool {
+		return latestTimestamp(histories[i]).After(latestTimestamp(histories[j]))
+	})
+	return histories, nil
+}
+
+// latestTimestamp returns the time of the most recent snapshot in a history,
+// or the zero time when it has none
+func latestTimestamp(history *model.OrderHistory) time.Time {
+	if len(history.Snapshots) == 0 {
+		return time.Time{}
+	}
+	return history.Snapshots[len(history.Snapshots)-1].Timestamp
+}
+
+// LatestSnapshots returns the most recent snapshot of every stored order,
+// sorted by reference number
+func (h *History) LatestSnapshots() ([]model.CombinedOrder, error) {
+	histories, err := h.ListAllHistories()
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []model.CombinedOrder
+	for _, history := range histories {
+		if len(history.Snapshots) > 0 {
+			orders = append(orders, history.Snapshots[len(history.Snapshots)-1].Data)
+		}
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Order.ReferenceNumber < orders[j].Order.ReferenceNumber
+	})
+	return orders, nil
+}
+
+// PruneOldEntries removes snapshots older than maxAge from every stored
+// history. The most recent snapshot of an order is always kept so changes
+// can still be detected on the next refresh. Files that can't be read or
+// saved are skipped; their errors are joined into the returned error for
+// the caller to log.
+func (h *History) PruneOldEntries(maxAge time.Duration) error {
+	entries, err := os.ReadDir(h.baseDir)
+	if err != nil {
+		return fmt.Errorf("failed to read history directory: %w", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		history, err := h.LoadHistory(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+			continue
+		}
+
+		pruned := pruneSnapshots(history.Snapshots, maxAge, now)
+		if len(pruned) == len(history.Snapshots) {
+			continue
+		}
+
+		history.Snapshots = pruned
+		if err := h.SaveHistory(history); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", entry.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// pruneSnapshots drops snapshots older than maxAge, always keeping the latest
+func pruneSnapshots(snapshots []model.HistoricalSnapshot, maxAge time.Duration, now time.Time) []model.HistoricalSnapshot {
+	if maxAge <= 0 || len(snapshots) <= 1 {
+		return snapshots
+	}
+
+	cutoff := now.Add(-maxAge)
+	var kept []model.HistoricalSnapshot
+	for i, snapshot := range snapshots {
+		if snapshot.Timestamp.After(cutoff) || i == len(snapshots)-1 {
+			kept = append(kept, snapshot)
+		}
+	}
+	return kept
+}
+
 // compareOrders delegates to the canonical model.CompareOrders
 func compareOrders(old, new model.CombinedOrder) []model.OrderDiff {
 	return model.CompareOrders(old, new)
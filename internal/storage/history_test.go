@@ -3,6 +3,7 @@ package storage
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -145,6 +146,318 @@ func TestHistory_SaveHistory_PrunesOldEntries(t *testing.T) {
 	}
 }
 
+func TestHistory_SetMaxEntries(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+	history.SetMaxEntries(5)
+
+	orderHistory := &model.OrderHistory{
+		ReferenceNumber: "RN123456789",
+		Snapshots:       make([]model.HistoricalSnapshot, 8),
+	}
+	for i := range orderHistory.Snapshots {
+		orderHistory.Snapshots[i] = model.HistoricalSnapshot{
+			Timestamp: time.Now().Add(time.Duration(-8+i) * time.Hour),
+			Data: model.CombinedOrder{
+				Order: model.TeslaOrder{ReferenceNumber: "RN123456789"},
+			},
+		}
+	}
+
+	if err := history.SaveHistory(orderHistory); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+
+	loaded, _ := history.LoadHistory("RN123456789")
+	if len(loaded.Snapshots) != 5 {
+		t.Errorf("Snapshots length = %d, want 5", len(loaded.Snapshots))
+	}
+
+	// Invalid values fall back to the default
+	history.SetMaxEntries(0)
+	if history.maxEntries != maxHistoryEntries {
+		t.Errorf("maxEntries = %d, want default %d", history.maxEntries, maxHistoryEntries)
+	}
+}
+
+func TestHistory_SaveHistory_PrunesByAge(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+	history.SetMaxAge(48 * time.Hour)
+
+	orderHistory := &model.OrderHistory{
+		ReferenceNumber: "RN123456789",
+		Snapshots: []model.HistoricalSnapshot{
+			{Timestamp: time.Now().Add(-96 * time.Hour), Data: model.CombinedOrder{Order: model.TeslaOrder{OrderStatus: "RESERVED"}}},
+			{Timestamp: time.Now().Add(-24 * time.Hour), Data: model.CombinedOrder{Order: model.TeslaOrder{OrderStatus: "BOOKED"}}},
+		},
+	}
+
+	if err := history.SaveHistory(orderHistory); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+
+	loaded, _ := history.LoadHistory("RN123456789")
+	if len(loaded.Snapshots) != 1 {
+		t.Fatalf("Snapshots length = %d, want 1", len(loaded.Snapshots))
+	}
+	if loaded.Snapshots[0].Data.Order.OrderStatus != "BOOKED" {
+		t.Errorf("OrderStatus = %q, want %q", loaded.Snapshots[0].Data.Order.OrderStatus, "BOOKED")
+	}
+}
+
+func TestHistory_PruneOldEntries(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+
+	now := time.Now()
+	histories := []*model.OrderHistory{
+		{
+			ReferenceNumber: "RN000000001",
+			Snapshots: []model.HistoricalSnapshot{
+				{Timestamp: now.Add(-60 * 24 * time.Hour)},
+				{Timestamp: now.Add(-40 * 24 * time.Hour)},
+				{Timestamp: now.Add(-10 * 24 * time.Hour)},
+				{Timestamp: now.Add(-time.Hour)},
+			},
+		},
+		{
+			// Only old snapshots: the latest one must survive
+			ReferenceNumber: "RN000000002",
+			Snapshots: []model.HistoricalSnapshot{
+				{Timestamp: now.Add(-50 * 24 * time.Hour)},
+				{Timestamp: now.Add(-45 * 24 * time.Hour)},
+			},
+		},
+	}
+	for _, h := range histories {
+		if err := history.SaveHistory(h); err != nil {
+			t.Fatalf("SaveHistory() error = %v", err)
+		}
+	}
+
+	if err := history.PruneOldEntries(30 * 24 * time.Hour); err != nil {
+		t.Fatalf("PruneOldEntries() error = %v", err)
+	}
+
+	first, _ := history.LoadHistory("RN000000001")
+	if len(first.Snapshots) != 2 {
+		t.Fatalf("RN000000001 snapshots = %d, want 2", len(first.Snapshots))
+	}
+	for _, s := range first.Snapshots {
+		if now.Sub(s.Timestamp) > 30*24*time.Hour {
+			t.Errorf("snapshot from %v should have been pruned", s.Timestamp)
+		}
+	}
+
+	second, _ := history.LoadHistory("RN000000002")
+	if len(second.Snapshots) != 1 {
+		t.Fatalf("RN000000002 snapshots = %d, want 1", len(second.Snapshots))
+	}
+	if !second.Snapshots[0].Timestamp.Equal(histories[1].Snapshots[1].Timestamp) {
+		t.Errorf("kept snapshot = %v, want the latest", second.Snapshots[0].Timestamp)
+	}
+}
+
+func TestHistory_PruneOldEntries_SkipsCorruptFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	history, _ := NewHistory(tempDir)
+
+	now := time.Now()
+	valid := &model.OrderHistory{
+		ReferenceNumber: "RN000000002",
+		Snapshots: []model.HistoricalSnapshot{
+			{Timestamp: now.Add(-60 * 24 * time.Hour)},
+			{Timestamp: now.Add(-time.Hour)},
+		},
+	}
+	if err := history.SaveHistory(valid); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+	// Sorts before the valid file, so it is read first
+	corrupt := filepath.Join(history.baseDir, "RN000000001.json")
+	if err := os.WriteFile(corrupt, []byte("{not json"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	err := history.PruneOldEntries(30 * 24 * time.Hour)
+	if err == nil || !strings.Contains(err.Error(), "RN000000001.json") {
+		t.Errorf("PruneOldEntries() error = %v, want one naming the corrupt file", err)
+	}
+
+	loaded, _ := history.LoadHistory("RN000000002")
+	if len(loaded.Snapshots) != 1 {
+		t.Errorf("RN000000002 snapshots = %d, want 1 (pruned despite the corrupt file)", len(loaded.Snapshots))
+	}
+}
+
+func TestHistory_PruneOldEntries_EmptyDir(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+	if err := history.PruneOldEntries(DefaultMaxHistoryAge); err != nil {
+		t.Errorf("PruneOldEntries() error = %v", err)
+	}
+}
+
+func TestHistory_LatestSnapshots(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+
+	orders, err := history.LatestSnapshots()
+	if err != nil {
+		t.Fatalf("LatestSnapshots() error = %v", err)
+	}
+	if len(orders) != 0 {
+		t.Errorf("LatestSnapshots() returned %d orders for empty history, want 0", len(orders))
+	}
+
+	for _, h := range []*model.OrderHistory{
+		{
+			ReferenceNumber: "RN000000002",
+			Snapshots: []model.HistoricalSnapshot{
+				{Timestamp: time.Now(), Data: model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN000000002", OrderStatus: "BOOKED"}}},
+			},
+		},
+		{
+			ReferenceNumber: "RN000000001",
+			Snapshots: []model.HistoricalSnapshot{
+				{Timestamp: time.Now().Add(-time.Hour), Data: model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN000000001", OrderStatus: "RESERVED"}}},
+				{Timestamp: time.Now(), Data: model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN000000001", OrderStatus: "BOOKED"}}},
+			},
+		},
+	} {
+		if err := history.SaveHistory(h); err != nil {
+			t.Fatalf("SaveHistory() error = %v", err)
+		}
+	}
+
+	orders, err = history.LatestSnapshots()
+	if err != nil {
+		t.Fatalf("LatestSnapshots() error = %v", err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("LatestSnapshots() returned %d orders, want 2", len(orders))
+	}
+	if orders[0].Order.ReferenceNumber != "RN000000001" || orders[0].Order.OrderStatus != "BOOKED" {
+		t.Errorf("orders[0] = %s/%s, want RN000000001/BOOKED", orders[0].Order.ReferenceNumber, orders[0].Order.OrderStatus)
+	}
+}
+
+func TestHistory_ListAllHistories(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+
+	histories, err := history.ListAllHistories()
+	if err != nil {
+		t.Fatalf("ListAllHistories() error = %v", err)
+	}
+	if len(histories) != 0 {
+		t.Errorf("ListAllHistories() returned %d histories for empty history, want 0", len(histories))
+	}
+
+	now := time.Now()
+	snapshot := func(ts time.Time, ref, status string) model.HistoricalSnapshot {
+		return model.HistoricalSnapshot{Timestamp: ts, Data: model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: ref, OrderStatus: status}}}
+	}
+	for _, h := range []*model.OrderHistory{
+		{
+			ReferenceNumber: "RN000000001",
+			Snapshots: []model.HistoricalSnapshot{
+				snapshot(now.Add(-3*time.Hour), "RN000000001", "RESERVED"),
+				snapshot(now.Add(-2*time.Hour), "RN000000001", "BOOKED"),
+			},
+		},
+		{
+			ReferenceNumber: "RN000000002",
+			Snapshots: []model.HistoricalSnapshot{
+				snapshot(now.Add(-time.Hour), "RN000000002", "IN_TRANSIT"),
+			},
+		},
+		{
+			ReferenceNumber: "RN000000003",
+			Snapshots: []model.HistoricalSnapshot{
+				snapshot(now.Add(-5*time.Hour), "RN000000003", "BOOKED"),
+				snapshot(now.Add(-4*time.Hour), "RN000000003", "BOOKED"),
+				snapshot(now.Add(-30*time.Minute), "RN000000003", "DELIVERED"),
+			},
+		},
+	} {
+		if err := history.SaveHistory(h); err != nil {
+			t.Fatalf("SaveHistory() error = %v", err)
+		}
+	}
+
+	// Files that are not order histories are skipped
+	if err := os.WriteFile(filepath.Join(tempDir, "notes.txt"), []byte("hello"), 0600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(tempDir, "backup.json"), 0700); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+
+	histories, err = history.ListAllHistories()
+	if err != nil {
+		t.Fatalf("ListAllHistories() error = %v", err)
+	}
+	if len(histories) != 3 {
+		t.Fatalf("ListAllHistories() returned %d histories, want 3", len(histories))
+	}
+
+	// Most recently updated first
+	want := []struct {
+		ref       string
+		snapshots int
+		status    string
+	}{
+		{"RN000000003", 3, "DELIVERED"},
+		{"RN000000002", 1, "IN_TRANSIT"},
+		{"RN000000001", 2, "BOOKED"},
+	}
+	for i, w := range want {
+		h := histories[i]
+		if h.ReferenceNumber != w.ref {
+			t.Errorf("histories[%d].ReferenceNumber = %q, want %q", i, h.ReferenceNumber, w.ref)
+			continue
+		}
+		if len(h.Snapshots) != w.snapshots {
+			t.Errorf("%s has %d snapshots, want %d", w.ref, len(h.Snapshots), w.snapshots)
+		}
+		if got := h.Snapshots[len(h.Snapshots)-1].Data.Order.OrderStatus; got != w.status {
+			t.Errorf("%s latest status = %q, want %q", w.ref, got, w.status)
+		}
+	}
+}
+
 func TestHistory_AddSnapshot_FirstSnapshot(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
 	if err != nil {
@@ -316,6 +629,57 @@ func TestHistory_GetLatestSnapshot(t *testing.T) {
 	}
 }
 
+func TestHistory_DeleteHistory(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+
+	order := model.CombinedOrder{
+		Order: model.TeslaOrder{
+			ReferenceNumber: "RN123456789",
+			OrderStatus:     "BOOKED",
+		},
+	}
+	if _, err := history.AddSnapshot(order); err != nil {
+		t.Fatalf("AddSnapshot() error = %v", err)
+	}
+
+	if err := history.DeleteHistory("RN123456789"); err != nil {
+		t.Fatalf("DeleteHistory() error = %v", err)
+	}
+
+	filePath := filepath.Join(tempDir, historyDirName, "RN123456789.json")
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Error("History file should have been removed")
+	}
+
+	loaded, err := history.LoadHistory("RN123456789")
+	if err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+	if len(loaded.Snapshots) != 0 {
+		t.Errorf("Snapshots length = %d, want 0", len(loaded.Snapshots))
+	}
+}
+
+func TestHistory_DeleteHistory_NoFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	history, _ := NewHistory(tempDir)
+
+	if err := history.DeleteHistory("RN000000000"); err != nil {
+		t.Errorf("DeleteHistory() on missing file error = %v, want nil", err)
+	}
+}
+
 func TestCompareOrders_AllFields(t *testing.T) {
 	vin1 := "VIN1"
 	vin2 := "VIN2"
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const pinsFileName = "pins.json"
+
+// Pins manages the set of pinned order reference numbers
+type Pins struct {
+	refs referenceSet
+}
+
+// NewPins creates a new Pins instance
+func NewPins(configDir string) (*Pins, error) {
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	return &Pins{refs: referenceSet{filePath: filepath.Join(configDir, pinsFileName), key: "pinned"}}, nil
+}
+
+// Load returns the set of pinned reference numbers
+func (p *Pins) Load() (map[string]bool, error) {
+	return p.refs.load()
+}
+
+// Toggle pins or unpins an order and returns the new state
+func (p *Pins) Toggle(referenceNumber string) (bool, error) {
+	return p.refs.toggle(referenceNumber)
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPins_ToggleAndPersist(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	pins, err := NewPins(tempDir)
+	if err != nil {
+		t.Fatalf("NewPins() error = %v", err)
+	}
+
+	for _, ref := range []string{"RN000000002", "RN000000001"} {
+		if pinned, err := pins.Toggle(ref); err != nil || !pinned {
+			t.Fatalf("Toggle(%q) = %v, %v; want true, nil", ref, pinned, err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(tempDir, pinsFileName))
+	if err != nil {
+		t.Fatalf("Failed to read pins file: %v", err)
+	}
+	if !strings.Contains(string(data), `"pinned"`) {
+		t.Errorf("pins file = %s, want a \"pinned\" list", data)
+	}
+
+	reloaded, _ := NewPins(tempDir)
+	pinned, err := reloaded.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(pinned) != 2 || !pinned["RN000000001"] || !pinned["RN000000002"] {
+		t.Errorf("Load() = %v, want both orders pinned", pinned)
+	}
+
+	if ok, _ := reloaded.Toggle("RN000000001"); ok {
+		t.Error("Toggle() = true, want false when unpinning")
+	}
+	pinned, _ = pins.Load()
+	if pinned["RN000000001"] {
+		t.Error("RN000000001 should be unpinned")
+	}
+}
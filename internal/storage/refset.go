@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// referenceSet persists a set of order reference numbers as a sorted JSON
+// list stored under key, e.g. {"archived": ["RN123"]}
+type referenceSet struct {
+	filePath string
+	key      string
+}
+
+// load returns the stored reference numbers
+func (r referenceSet) load() (map[string]bool, error) {
+	refs := make(map[string]bool)
+
+	data, err := os.ReadFile(r.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return refs, nil
+		}
+		return nil, fmt.Errorf("failed to read %s file: %w", r.key, err)
+	}
+
+	var file map[string][]string
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s file: %w", r.key, err)
+	}
+	for _, ref := range file[r.key] {
+		refs[ref] = true
+	}
+
+	return refs, nil
+}
+
+// save writes the reference numbers
+func (r referenceSet) save(refs map[string]bool) error {
+	list := make([]string, 0, len(refs))
+	for ref := range refs {
+		list = append(list, ref)
+	}
+	sort.Strings(list)
+
+	data, err := json.MarshalIndent(map[string][]string{r.key: list}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", r.key, err)
+	}
+
+	if err := os.WriteFile(r.filePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %s file: %w", r.key, err)
+	}
+
+	return nil
+}
+
+// toggle adds or removes a reference number and returns whether it is now
+// in the set
+func (r referenceSet) toggle(referenceNumber string) (bool, error) {
+	refs, err := r.load()
+	if err != nil {
+		return false, err
+	}
+
+	if refs[referenceNumber] {
+		delete(refs, referenceNumber)
+	} else {
+		refs[referenceNumber] = true
+	}
+
+	if err := r.save(refs); err != nil {
+		return false, err
+	}
+	return refs[referenceNumber], nil
+}
@@ -1,13 +1,18 @@
 package tui
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/url"
+	"os"
 	"os/exec"
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,14 +24,18 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/pkg/browser"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/data"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/export"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/format"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/update"
 )
 
 // View represents the current view
@@ -37,6 +46,9 @@ const (
 	ViewOrders
 	ViewDetail
 	ViewHelp
+	ViewSettings
+	ViewStats
+	ViewChangeLog
 )
 
 // Tab represents tabs in the detail view
@@ -56,13 +68,18 @@ type (
 	AuthResultMsg struct {
 		Tokens *model.TeslaTokens
 		Error  error
+		Notice string // one-time token storage migration notice
 	}
 
 	// OrdersLoadedMsg contains loaded orders
 	OrdersLoadedMsg struct {
 		Orders []model.CombinedOrder
-		Diffs  map[string][]model.OrderDiff
-		Error  error
+		Diffs  map[string][]model.OrderDiff // changes not yet acknowledged
+		// NewDiffs holds only the changes detected by this refresh
+		NewDiffs  map[string][]model.OrderDiff
+		Timelines map[string]orderTimeline
+		Offline   bool // orders are cached snapshots because the network is unavailable
+		Error     error
 	}
 
 	// TickMsg for auto-refresh
@@ -96,6 +113,9 @@ type (
 	// AutoRefreshTickMsg triggers auto-refresh
 	AutoRefreshTickMsg time.Time
 
+	// CountdownTickMsg advances the live delivery countdown
+	CountdownTickMsg time.Time
+
 	// ClipboardMsg indicates text was copied to clipboard
 	ClipboardMsg struct {
 		Text    string
@@ -103,39 +123,115 @@ type (
 		Error   error
 	}
 
-	// LogoutMsg indicates the user has been logged out
-	LogoutMsg struct{}
+	// LogoutMsg indicates the user has been logged out. Reason is shown as a
+	// toast when the logout didn't come from the user.
+	LogoutMsg struct {
+		Reason string
+	}
+
+	// TokenWatchTickMsg triggers a check that the saved tokens still exist
+	TokenWatchTickMsg time.Time
+
+	// ConfettiTickMsg advances the delivery confetti animation
+	ConfettiTickMsg time.Time
 
 	// ChecklistToggleMsg indicates a checklist item was toggled
 	ChecklistToggleMsg struct {
-		ItemID   string
-		Checked  bool
-		Error    error
+		ReferenceNumber string
+		ItemID          string
+		Checked         bool
+		Undo            bool // the toggle reverses an earlier one; not recorded for undo
+		Error           error
 	}
-)
 
-// Compiled regexes for JSON syntax highlighting
-var (
-	jsonKeyRe    = regexp.MustCompile(`^(\s*)"([^"]+)"\s*:`)
-	jsonStringRe = regexp.MustCompile(`:\s*"([^"]*)"`)
-	jsonNumberRe = regexp.MustCompile(`:\s*(-?\d+\.?\d*(?:[eE][+-]?\d+)?)`)
-	jsonBoolRe   = regexp.MustCompile(`:\s*(true|false)`)
-	jsonNullRe   = regexp.MustCompile(`:\s*(null)`)
+	// ChecklistResetMsg indicates the checklist for an order was reset
+	ChecklistResetMsg struct {
+		Error error
+	}
+
+	// HistoryDeletedMsg indicates the history for an order was deleted
+	HistoryDeletedMsg struct {
+		ReferenceNumber string
+		Error           error
+	}
+
+	// ProgressMsg reports how many order details have been fetched
+	ProgressMsg struct {
+		Current int
+		Total   int
+	}
+
+	// ArchiveToggledMsg indicates an order was archived or unarchived
+	ArchiveToggledMsg struct {
+		ReferenceNumber string
+		Archived        bool
+		Error           error
+	}
+
+	// PinToggledMsg indicates an order was pinned or unpinned
+	PinToggledMsg struct {
+		ReferenceNumber string
+		Pinned          bool
+		Error           error
+	}
+
+	// ChangesAcknowledgedMsg indicates the changes for an order were dismissed
+	ChangesAcknowledgedMsg struct {
+		ReferenceNumber string
+		Error           error
+	}
+
+	// UpdateAvailableMsg reports a newer release found at startup
+	UpdateAvailableMsg struct {
+		Latest string
+	}
+
+	// FieldAcknowledgedMsg indicates the change to one field was marked as seen
+	FieldAcknowledgedMsg struct {
+		ReferenceNumber string
+		Field           string
+		Error           error
+	}
 )
 
+// timeNow returns the current time; tests override it for deterministic output
+var timeNow = time.Now
+
+// jsonLineRe matches a JSON object key and, when it is a scalar, its value.
+// Groups: 1 indent, 2 key, 3 separator, 4 null, 5 bool, 6 number, 7 string.
+var jsonLineRe = regexp.MustCompile(`^(\s*)("[^"]+")(\s*:\s*)(?:(null)|(true|false)|(-?\d+\.?\d*(?:[eE][+-]?\d+)?)|("[^"]*"))?`)
+
+// jsonValueStyles holds the style for each value group of jsonLineRe
+var jsonValueStyles = []struct {
+	group int
+	style *lipgloss.Style
+}{
+	{4, &JSONNullStyle},
+	{5, &JSONBoolStyle},
+	{6, &JSONNumberStyle},
+	{7, &JSONStringStyle},
+}
+
 // Model is the main application model
 type Model struct {
 	// Dependencies
 	config    *config.Config
-	client    *api.Client
+	client    api.ApiClient
 	history   *storage.History
 	checklist *storage.Checklist
+	acks      *storage.Acknowledgements
+	archive   *storage.Archive
+	pins      *storage.Pins
+	logger    *slog.Logger
+	debugLog  *slog.Logger // --debug: every message passed to Update; nil when off
 
 	// State
 	view             View
 	previousView     View // for returning from help
 	tokens           *model.TeslaTokens
 	orders           []model.CombinedOrder
+	loadedOrders     []model.CombinedOrder // orders as loaded, for the default sort
+	sortMode         int                   // orders list sort, cycled with 's'
 	diffs            map[string][]model.OrderDiff
 	selectedOrder    int
 	selectedTab      Tab
@@ -144,21 +240,102 @@ type Model struct {
 	authenticating   bool
 	authSession      *api.AuthSession
 	demoMode         bool
+	demoScenario     string
+	regionOverride   bool // region set with --region; skip detection at login
+	currentVersion   string // compared to the latest release at startup; empty skips the check
+	hideArt          bool   // --no-art: no vehicle silhouette in the Details tab
+	minimalMode      bool   // --minimal: no art, animations or decorative borders
+	noColor          bool   // --no-color: ASCII table borders
 	demoHistory      map[string]*model.OrderHistory
+	timelines        map[string]orderTimeline // per reference number, built when orders load
+	offline          bool // orders are cached history snapshots; the API was unreachable
+	showArchived     bool // include archived orders in the orders list
+	pinned           map[string]bool // pinned reference numbers, shown first
+	watched          map[string]bool // reference numbers auto-refresh updates; empty updates all
 	confirmingLogout bool
+	deleteConfirming bool
+	resetConfirming  bool
 
 	// Checklist
-	checklistState  *storage.ChecklistState
-	checklistCursor int
+	checklistState    *storage.ChecklistState
+	checklistCursor   int
+	checklistExpanded map[string]bool
+	checklistUndo     []checklistUndoEntry // session-only, newest last
+
+	// Tasks tab: index of the focused task in the sorted task list
+	taskCursor int
+
+	// Tasks tab overlay with every card field of focusedTask (the task name)
+	taskDetailOverlay bool
+	focusedTask       string
+
+	// Details tab field focus: the cursor moves over the order's
+	// unacknowledged changes so they can be marked as seen one at a time
+	detailFocus  bool
+	detailCursor int
+
+	// History tab: index of the focused snapshot, -1 when none is focused
+	historyIndex int
+
+	// JSON tab search
+	searchInput       textinput.Model
+	jsonSearching     bool   // the search bar has focus
+	jsonSearchQuery   string
+	jsonSearchMatches []int // line numbers of matches in the JSON tab
+	jsonSearchIndex   int   // current match in jsonSearchMatches
+	jsonScrollX       int   // horizontal scroll offset of the JSON tab, in columns
+	rawViewMode       bool  // JSON tab shows the unprocessed details response
+
+	// Delivery confetti: current frame, 0 when no animation is running
+	animationFrame int
 
 	// Toast notification
-	toastMessage string
-	toastIsError bool
+	toastMessage  string
+	toastIsError  bool
+	toastDuration time.Duration
+
+	// Minimum terminal size; smaller terminals show a warning instead
+	minWidth  int
+	minHeight int
+
+	// Settings
+	settingsCursor       int
+	settingsDraft        config.Settings
+	notificationsEnabled bool
+	location             *time.Location // appointment timezone; nil when not configured
+	units                string         // odometer unit system; empty shows API units
+	dateFormat           string         // one of format.DateFormats
+	soundAlert           bool           // ring the terminal bell on critical changes
+	columns              []orderColumn  // visible orders table columns
 
 	// Auto-refresh
 	autoRefresh         bool
 	autoRefreshInterval time.Duration
 	lastRefresh         time.Time
+	countdownTick       time.Time // time of the last countdown tick; zero when not ticking
+
+	// Loading progress, fed by the API client while orders are fetched
+	progressCh      chan ProgressMsg
+	loadingProgress ProgressMsg
+	progressBar     progress.Model // animates towards the loaded share of loadingProgress
+
+	// tabXBoundaries holds the screen X where each detail tab starts, plus
+	// the end of the last tab, as last rendered by renderTabs. It is shared
+	// through a pointer because View has a value receiver.
+	tabXBoundaries *[]int
+
+	// changedColumnX holds the screen X range [start, end) of the orders
+	// table's Changed column as last rendered by viewOrders, shared through a
+	// pointer like tabXBoundaries. hoveredOrder is the order whose change
+	// indicator the mouse is over, -1 when none.
+	changedColumnX *[2]int
+	hoveredOrder   int
+
+	// changeLog holds the changes across all orders, newest first, loaded
+	// when the change log view opens. changeLogScroll is the first visible
+	// entry.
+	changeLog       []changeLogEntry
+	changeLogScroll int
 
 	// UI Components
 	spinner   spinner.Model
@@ -172,8 +349,14 @@ type Model struct {
 	height int
 }
 
+// defaultAutoRefreshInterval is used when no interval has been saved
+const defaultAutoRefreshInterval = 5 * time.Minute
+
+// defaultToastDuration is used when no toast duration has been saved
+const defaultToastDuration = 3 * time.Second
+
 // New creates a new Model
-func New(cfg *config.Config, client *api.Client, hist *storage.History, cl *storage.Checklist) Model {
+func New(cfg *config.Config, client api.ApiClient, hist *storage.History, cl *storage.Checklist) Model {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = SpinnerStyle
@@ -183,6 +366,11 @@ func New(cfg *config.Config, client *api.Client, hist *storage.History, cl *stor
 	ti.CharLimit = 2000
 	ti.Width = 60
 
+	si := textinput.New()
+	si.Prompt = "/"
+	si.Placeholder = "search JSON"
+	si.CharLimit = 200
+
 	vp := viewport.New(80, 20)
 	vp.MouseWheelEnabled = true
 	vp.MouseWheelDelta = 3
@@ -196,19 +384,95 @@ func New(cfg *config.Config, client *api.Client, hist *storage.History, cl *stor
 	h.Styles.ShortSeparator = HelpDescStyle
 	h.ShowAll = true
 
+	refreshInterval := defaultAutoRefreshInterval
+	toastDuration := defaultToastDuration
+	notifications := true
+	var location *time.Location
+	var units string
+	var soundAlert bool
+	var watchedOrders []string
+	dateFormat := format.DateLongUS
+	var columnNames []string
+	minWidth, minHeight := minTerminalWidth, minTerminalHeight
+	if cfg != nil {
+		if saved := cfg.Settings().AutoRefreshInterval; saved > 0 {
+			refreshInterval = saved
+		}
+		if saved := cfg.Settings().ToastDuration; saved > 0 {
+			toastDuration = saved
+		}
+		notifications = cfg.Settings().NotificationsEnabled
+		location = loadLocation(cfg.Settings().Timezone)
+		units = cfg.Settings().Units
+		soundAlert = cfg.Settings().SoundAlert
+		if saved := cfg.Settings().DateFormat; saved != "" {
+			dateFormat = saved
+		}
+		columnNames = cfg.Settings().VisibleColumns
+		watchedOrders = cfg.Settings().WatchedOrders
+		if saved := cfg.Settings().MinTerminalWidth; saved > 0 {
+			minWidth = saved
+		}
+		if saved := cfg.Settings().MinTerminalHeight; saved > 0 {
+			minHeight = saved
+		}
+	}
+
+	columns, unknownColumns := resolveColumns(columnNames)
+	var toast string
+	if len(unknownColumns) > 0 {
+		toast = "Unknown columns in settings: " + strings.Join(unknownColumns, ", ")
+	}
+
 	return Model{
 		config:    cfg,
 		client:    client,
 		history:   hist,
 		checklist: cl,
+		logger:    slog.New(slog.DiscardHandler),
 		view:      ViewLogin,
 		keys:      DefaultKeyMap,
 		spinner:   s,
 		textInput: ti,
+		searchInput: si,
 		viewport:  vp,
 		help:      h,
 		diffs:     make(map[string][]model.OrderDiff),
+
+		checklistExpanded:    make(map[string]bool),
+		historyIndex:         -1,
+		toastDuration:        toastDuration,
+		minWidth:             minWidth,
+		minHeight:            minHeight,
+		autoRefreshInterval:  refreshInterval,
+		notificationsEnabled: notifications,
+		location:             location,
+		units:                units,
+		dateFormat:           dateFormat,
+		soundAlert:           soundAlert,
+		watched:              watchedSet(watchedOrders),
+		columns:              columns,
+		progressCh:           make(chan ProgressMsg, 16),
+		progressBar:          newLoadingProgressBar(),
+		tabXBoundaries:       new([]int),
+		changedColumnX:       new([2]int),
+		hoveredOrder:         -1,
+		toastMessage:         toast,
+		toastIsError:         toast != "",
+	}
+}
+
+// loadLocation resolves an IANA timezone name, returning nil when it is
+// empty or unknown so callers can fall back to UTC
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
 	}
+	return loc
 }
 
 // WithDemoMode enables demo mode with mock data
@@ -217,6 +481,13 @@ func (m Model) WithDemoMode() Model {
 	return m
 }
 
+// WithDemoScenario enables demo mode using the named demo scenario
+func (m Model) WithDemoScenario(name string) Model {
+	m.demoMode = true
+	m.demoScenario = name
+	return m
+}
+
 // WithAutoRefresh enables automatic refresh at the specified interval
 func (m Model) WithAutoRefresh(interval time.Duration) Model {
 	m.autoRefresh = true
@@ -224,26 +495,184 @@ func (m Model) WithAutoRefresh(interval time.Duration) Model {
 	return m
 }
 
+// WithRegion pins the API region, disabling detection from the login token
+func (m Model) WithRegion(region string) Model {
+	m.client.SetRegion(region)
+	m.regionOverride = true
+	return m
+}
+
+// WithToastDuration sets how long toast notifications stay visible
+func (m Model) WithToastDuration(d time.Duration) Model {
+	m.toastDuration = d
+	return m
+}
+
+// WithMinTerminalSize overrides the saved minimum terminal size; zero keeps
+// the saved value for that dimension
+func (m Model) WithMinTerminalSize(width, height int) Model {
+	if width > 0 {
+		m.minWidth = width
+	}
+	if height > 0 {
+		m.minHeight = height
+	}
+	return m
+}
+
+// WithLogger sets the structured logger used for change detection events
+func (m Model) WithLogger(logger *slog.Logger) Model {
+	m.logger = logger
+	return m
+}
+
+// WithDebug logs the type and key fields of every message passed to Update
+// at debug level, for developers following the message flow
+func (m Model) WithDebug(logger *slog.Logger) Model {
+	m.debugLog = logger
+	return m
+}
+
+// WithoutArt hides the vehicle silhouette in the Details tab
+func (m Model) WithoutArt() Model {
+	m.hideArt = true
+	return m
+}
+
+// WithMinimal renders without ASCII art, animations and decorative borders,
+// for slow terminals and screen readers
+func (m Model) WithMinimal() Model {
+	m.minimalMode = true
+	m.hideArt = true
+	return m
+}
+
+// WithNoColor draws table borders with plain ASCII characters, for use
+// with DisableColor
+func (m Model) WithNoColor() Model {
+	m.noColor = true
+	return m
+}
+
+// WithSoundAlert rings the terminal bell when a VIN is assigned or a
+// delivery appointment is booked, regardless of the saved setting
+func (m Model) WithSoundAlert() Model {
+	m.soundAlert = true
+	return m
+}
+
+// WithUpdateCheck shows a hint at startup when a release newer than
+// currentVersion is available
+func (m Model) WithUpdateCheck(currentVersion string) Model {
+	m.currentVersion = currentVersion
+	return m
+}
+
+// WithAcknowledgements enables persistent change notifications that remain
+// until dismissed with 'a'
+func (m Model) WithAcknowledgements(acks *storage.Acknowledgements) Model {
+	m.acks = acks
+	return m
+}
+
+// WithArchive enables archiving orders with 'A'. Archived orders are hidden
+// from the orders list unless showArchived is set.
+func (m Model) WithArchive(archive *storage.Archive, showArchived bool) Model {
+	m.archive = archive
+	m.showArchived = showArchived
+	return m
+}
+
+// WithPins enables pinning orders to the top of the list with 'P'
+func (m Model) WithPins(pins *storage.Pins) Model {
+	m.pins = pins
+	pinned, err := pins.Load()
+	if err != nil {
+		m.logger.Error("pins", "error", err.Error())
+		pinned = make(map[string]bool)
+	}
+	m.pinned = pinned
+	return m
+}
+
 // Init initializes the model
 func (m Model) Init() tea.Cmd {
+	// Clear any startup warning like other toasts
+	var clearToast tea.Cmd
+	if m.toastMessage != "" {
+		clearToast = m.clearToastAfterDelay()
+	}
+
 	if m.demoMode {
 		return tea.Batch(
 			m.spinner.Tick,
 			m.loadDemoData,
+			clearToast,
 		)
 	}
 	return tea.Batch(
 		m.spinner.Tick,
 		m.checkSavedTokens,
+		watchTokens(),
+		m.waitForProgress(),
+		m.checkForUpdate(),
+		clearToast,
+	)
+}
+
+// checkForUpdate looks up the latest release in the background. Failures
+// are only logged; the check is a hint, not a requirement.
+func (m Model) checkForUpdate() tea.Cmd {
+	if m.currentVersion == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		result, err := update.Check(context.Background(), m.currentVersion)
+		if err != nil {
+			m.logger.Warn("version check", "error", err.Error())
+			return nil
+		}
+		if !result.UpdateAvailable {
+			return nil
+		}
+		return UpdateAvailableMsg{Latest: result.Latest}
+	}
+}
+
+// waitForProgress waits for the next progress update from loadOrders
+func (m Model) waitForProgress() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.progressCh
+	}
+}
+
+// newLoadingProgressBar returns the bar shown while order details load
+func newLoadingProgressBar() progress.Model {
+	return progress.New(
+		progress.WithSolidFill(string(TeslaRed)),
+		progress.WithWidth(40),
+		progress.WithColorProfile(lipgloss.ColorProfile()),
 	)
 }
 
+// reportProgress forwards API progress without blocking the fetch
+func (m Model) reportProgress(current, total int) {
+	select {
+	case m.progressCh <- ProgressMsg{Current: current, Total: total}:
+	default:
+	}
+}
+
 // loadDemoData loads mock data for demo mode
 func (m Model) loadDemoData() tea.Msg {
+	orders, diffs, history, err := demo.GetDemoScenario(m.demoScenario)
+	if err != nil {
+		return ErrMsg{err}
+	}
 	return DemoLoadedMsg{
-		Orders:  demo.GetDemoOrders(),
-		Diffs:   demo.GetDemoDiffs(),
-		History: demo.GetDemoHistory(),
+		Orders:  orders,
+		Diffs:   diffs,
+		History: history,
 	}
 }
 
@@ -256,10 +685,11 @@ func (m Model) checkSavedTokens() tea.Msg {
 	if tokens == nil {
 		return nil
 	}
+	notice := m.config.TakeMigrationNotice()
 
 	// If tokens are still valid, use them
 	if !tokens.IsExpired() {
-		return AuthResultMsg{Tokens: tokens}
+		return AuthResultMsg{Tokens: tokens, Notice: notice}
 	}
 
 	// Access token expired, try to refresh using the refresh token
@@ -270,7 +700,7 @@ func (m Model) checkSavedTokens() tea.Msg {
 			if saveErr := m.config.SaveTokens(newTokens); saveErr != nil {
 				return AuthResultMsg{Error: fmt.Errorf("failed to save refreshed tokens: %w", saveErr)}
 			}
-			return AuthResultMsg{Tokens: newTokens}
+			return AuthResultMsg{Tokens: newTokens, Notice: notice}
 		}
 		// Refresh failed, show the error so the user knows why re-authentication is needed
 		return AuthResultMsg{Error: fmt.Errorf("session expired, please sign in again (%w)", err)}
@@ -281,6 +711,37 @@ func (m Model) checkSavedTokens() tea.Msg {
 
 // Update handles messages
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.debugLog != nil {
+		m.debugLog.Debug("update", append([]any{"msg", fmt.Sprintf("%T", msg)}, debugFields(msg)...)...)
+	}
+	return m.update(msg)
+}
+
+// debugFields returns the key fields of a message for the --debug log
+func debugFields(msg tea.Msg) []any {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return []any{"key", msg.String()}
+	case tea.MouseMsg:
+		return []any{"mouse", msg.String(), "x", msg.X, "y", msg.Y}
+	case tea.WindowSizeMsg:
+		return []any{"width", msg.Width, "height", msg.Height}
+	case OrdersLoadedMsg:
+		fields := []any{"orders", len(msg.Orders), "changed", len(msg.Diffs), "offline", msg.Offline}
+		if msg.Error != nil {
+			fields = append(fields, "error", msg.Error.Error())
+		}
+		return fields
+	case ToastMsg:
+		return []any{"message", msg.Message, "isError", msg.IsError}
+	case ErrMsg:
+		return []any{"error", msg.Error()}
+	}
+	return nil
+}
+
+// update handles messages; Update wraps it to log messages in debug mode
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
@@ -334,10 +795,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.view = ViewOrders
 		m.loading = true
-		return m, tea.Batch(m.spinner.Tick, m.loadOrders)
+		cmds := []tea.Cmd{m.spinner.Tick, m.detectRegion(msg.Tokens), m.loadOrders}
+		if msg.Notice != "" {
+			m.toastMessage = "✓ " + msg.Notice
+			m.toastIsError = false
+			cmds = append(cmds, m.clearToastAfterDelay())
+		}
+		return m, tea.Batch(cmds...)
+
+	case ProgressMsg:
+		// Ignore updates that arrive after loading finished
+		if m.loading && msg.Total > 0 {
+			m.loadingProgress = msg
+			cmd := m.progressBar.SetPercent(float64(msg.Current) / float64(msg.Total))
+			return m, tea.Batch(m.waitForProgress(), cmd)
+		}
+		return m, m.waitForProgress()
+
+	case progress.FrameMsg:
+		updated, cmd := m.progressBar.Update(msg)
+		m.progressBar = updated.(progress.Model)
+		return m, cmd
 
 	case OrdersLoadedMsg:
 		m.loading = false
+		m.loadingProgress = ProgressMsg{}
+		m.progressBar = newLoadingProgressBar()
 		m.lastRefresh = time.Now()
 		if msg.Error != nil {
 			m.err = msg.Error
@@ -347,22 +830,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		}
-		m.orders = msg.Orders
+		previous := m.orders
+		m.loadedOrders = msg.Orders
+		m.orders = sortPinned(sortOrders(msg.Orders, m.sortMode), m.pinned)
 		m.diffs = msg.Diffs
+		m.timelines = msg.Timelines
+		m.offline = msg.Offline
 		m.err = nil
 
-		// Show toast notification with refresh result
-		changeCount := len(msg.Diffs)
-		if changeCount > 0 {
-			m.toastMessage = fmt.Sprintf("✓ Refreshed - %d order(s) with changes", changeCount)
-		} else {
-			m.toastMessage = "✓ Refreshed - no changes detected"
-		}
-		m.toastIsError = false
-
 		// Schedule next auto-refresh if enabled
 		var cmds []tea.Cmd
-		cmds = append(cmds, m.clearToastAfterDelay())
+
+		// Show toast notification with refresh result
+		if m.notificationsEnabled && !m.offline {
+			changeCount := len(msg.Diffs)
+			if changeCount > 0 {
+				m.toastMessage = fmt.Sprintf("✓ Refreshed - %d order(s) with changes", changeCount)
+			} else {
+				m.toastMessage = "✓ Refreshed - no changes detected"
+			}
+			m.toastIsError = false
+			cmds = append(cmds, m.clearToastAfterDelay())
+		}
+		// Only ring for changes found now, not ones still awaiting acknowledgement
+		if m.soundAlert && !m.offline && hasCriticalChange(msg.NewDiffs) {
+			cmds = append(cmds, playAlert())
+		}
+		if !m.minimalMode && !m.offline && m.animationFrame == 0 && becameDelivered(previous, msg.Diffs) {
+			m.animationFrame = 1
+			cmds = append(cmds, confettiTick())
+		}
 		if m.autoRefresh {
 			cmds = append(cmds, m.scheduleAutoRefresh())
 		}
@@ -374,15 +871,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.clearToastAfterDelay()
 
 	case ClearToastMsg:
+		// Keep the delete prompt visible until it is answered
+		if m.deleteConfirming {
+			return m, nil
+		}
 		m.toastMessage = ""
 		m.toastIsError = false
 		return m, nil
 
 	case DemoLoadedMsg:
 		m.loading = false
-		m.orders = msg.Orders
+		m.loadedOrders = msg.Orders
+		m.orders = sortPinned(sortOrders(msg.Orders, m.sortMode), m.pinned)
 		m.diffs = msg.Diffs
 		m.demoHistory = msg.History
+		m.timelines = m.buildTimelines(msg.Orders)
 		m.view = ViewOrders
 		m.err = nil
 		return m, nil
@@ -396,7 +899,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Only refresh if we're on the orders view and not already loading
 		if m.view == ViewOrders && !m.loading && m.tokens != nil {
 			m.loading = true
-			return m, tea.Batch(m.spinner.Tick, m.loadOrders)
+			return m, tea.Batch(m.spinner.Tick, m.loadWatchedOrders)
 		}
 		// Reschedule if we couldn't refresh now
 		if m.autoRefresh {
@@ -404,21 +907,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case CountdownTickMsg:
+		if !m.countdownActive() {
+			m.countdownTick = time.Time{}
+			return m, nil
+		}
+		m.countdownTick = time.Time(msg)
+		if m.selectedTab == TabDetails {
+			m.viewport.SetContent(m.getTabContent())
+		}
+		return m, countdownTickCmd()
+
 	case LogoutMsg:
 		m.tokens = nil
 		m.orders = nil
+		m.loadedOrders = nil
 		m.diffs = make(map[string][]model.OrderDiff)
 		m.checklistState = nil
 		m.view = ViewLogin
 		m.err = nil
+		if msg.Reason != "" {
+			m.toastMessage = msg.Reason
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
 		return m, nil
 
+	case ConfettiTickMsg:
+		if m.animationFrame == 0 {
+			return m, nil
+		}
+		m.animationFrame++
+		if m.animationFrame > confettiFrames {
+			m.animationFrame = 0
+			return m, nil
+		}
+		return m, confettiTick()
+
+	case TokenWatchTickMsg:
+		// Tokens deleted from outside the app (file or keyring) end the
+		// session now, instead of with an auth error at the next refresh
+		if m.tokens != nil && m.config != nil {
+			return m, tea.Batch(watchTokens(), m.checkTokensSaved)
+		}
+		return m, watchTokens()
+
 	case ChecklistToggleMsg:
 		if msg.Error != nil {
 			m.toastMessage = "✗ Failed to save checklist"
 			m.toastIsError = true
 			return m, m.clearToastAfterDelay()
 		}
+		if !msg.Undo {
+			m.pushChecklistUndo(checklistUndoEntry{ReferenceNumber: msg.ReferenceNumber, ItemID: msg.ItemID, Previous: !msg.Checked})
+		}
 		// Reload checklist state
 		if m.selectedOrder < len(m.orders) {
 			ref := m.orders[m.selectedOrder].Order.ReferenceNumber
@@ -430,6 +972,137 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.viewport.SetContent(m.getTabContent())
 		return m, nil
 
+	case ChecklistResetMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to reset checklist"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		// Undo flips items, which would be wrong after a reset
+		m.checklistUndo = nil
+		if m.selectedOrder < len(m.orders) {
+			ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+			state, err := m.checklist.LoadState(ref)
+			if err == nil {
+				m.checklistState = state
+			}
+		}
+		m.checklistCursor = 0
+		m.viewport.SetContent(m.getTabContent())
+		m.viewport.GotoTop()
+		m.toastMessage = "✓ Checklist reset"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
+	case HistoryDeletedMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to delete history"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		delete(m.diffs, msg.ReferenceNumber)
+		delete(m.timelines, msg.ReferenceNumber)
+		m.viewport.SetContent(m.getTabContent())
+		m.viewport.GotoTop()
+		m.toastMessage = "✓ History deleted"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
+	case PinToggledMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to update pins"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		if m.pinned == nil {
+			m.pinned = make(map[string]bool)
+		}
+		if msg.Pinned {
+			m.pinned[msg.ReferenceNumber] = true
+			m.toastMessage = "✓ Order pinned"
+		} else {
+			delete(m.pinned, msg.ReferenceNumber)
+			m.toastMessage = "✓ Order unpinned"
+		}
+		m.toastIsError = false
+
+		// Re-sort and keep the cursor on the toggled order
+		m.orders = sortPinned(sortOrders(m.loadedOrders, m.sortMode), m.pinned)
+		for i, order := range m.orders {
+			if order.Order.ReferenceNumber == msg.ReferenceNumber {
+				m.selectedOrder = i
+				break
+			}
+		}
+		return m, m.clearToastAfterDelay()
+
+	case ArchiveToggledMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to update archive"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		if !msg.Archived {
+			m.toastMessage = "✓ Order unarchived"
+			m.toastIsError = false
+			return m, m.clearToastAfterDelay()
+		}
+		if !m.showArchived {
+			m.orders = removeOrder(m.orders, msg.ReferenceNumber)
+			m.loadedOrders = removeOrder(m.loadedOrders, msg.ReferenceNumber)
+			delete(m.diffs, msg.ReferenceNumber)
+			if m.selectedOrder >= len(m.orders) && m.selectedOrder > 0 {
+				m.selectedOrder = len(m.orders) - 1
+			}
+		}
+		m.toastMessage = "✓ Order archived"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
+	case ChangesAcknowledgedMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to acknowledge changes"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		delete(m.diffs, msg.ReferenceNumber)
+		m.detailFocus = false
+		m.viewport.SetContent(m.getTabContent())
+		m.toastMessage = "✓ Changes acknowledged"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
+	case UpdateAvailableMsg:
+		m.toastMessage = "Update available: " + msg.Latest
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
+	case FieldAcknowledgedMsg:
+		if msg.Error != nil {
+			m.toastMessage = "✗ Failed to acknowledge change"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		var remaining []model.OrderDiff
+		for _, diff := range m.diffs[msg.ReferenceNumber] {
+			if diff.Field != msg.Field {
+				remaining = append(remaining, diff)
+			}
+		}
+		if len(remaining) == 0 {
+			delete(m.diffs, msg.ReferenceNumber)
+			m.detailFocus = false
+		} else {
+			m.diffs[msg.ReferenceNumber] = remaining
+		}
+		if m.detailCursor >= len(remaining) && m.detailCursor > 0 {
+			m.detailCursor = len(remaining) - 1
+		}
+		m.viewport.SetContent(m.getTabContent())
+		m.toastMessage = "✓ " + msg.Field + " marked as seen"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+
 	case ClipboardMsg:
 		if msg.Success {
 			label := msg.Text
@@ -438,6 +1111,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.toastMessage = fmt.Sprintf("✓ Copied: %s", label)
 			m.toastIsError = false
+		} else if errors.Is(msg.Error, errNoClipboardTool) {
+			m.toastMessage = "✗ No clipboard tool found (install xclip, xsel, or wl-clipboard)"
+			m.toastIsError = true
 		} else {
 			m.toastMessage = "✗ Failed to copy to clipboard"
 			m.toastIsError = true
@@ -466,13 +1142,59 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	// Global keys
-	switch msg.String() {
-	case "q", "ctrl+c":
-		return m, tea.Quit
-	case "?":
-		// Toggle help view; skip when already showing help (handled by handleHelpKeys)
-		if m.view == ViewHelp {
+	// Handle history delete confirmation
+	if m.deleteConfirming {
+		switch msg.String() {
+		case "y", "Y":
+			m.deleteConfirming = false
+			m.toastMessage = ""
+			return m, m.deleteHistory()
+		case "n", "N", "esc":
+			m.deleteConfirming = false
+			m.toastMessage = ""
+			return m, nil
+		}
+		return m, nil
+	}
+
+	// Handle checklist reset confirmation
+	if m.resetConfirming {
+		switch msg.String() {
+		case "y", "Y":
+			m.resetConfirming = false
+			return m, m.resetChecklist()
+		case "n", "N", "esc":
+			m.resetConfirming = false
+			return m, nil
+		}
+		return m, nil
+	}
+
+	// The JSON search bar captures all keys while it has focus
+	if m.jsonSearching {
+		return m.handleJSONSearchKeys(msg)
+	}
+
+	// Global keys
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "S":
+		// Toggle settings view; closing is handled by handleSettingsKeys so the draft is saved
+		if m.view != ViewSettings && m.config != nil && (m.view != ViewLogin || (!m.authenticating && m.authSession == nil)) {
+			m.previousView = m.view
+			m.view = ViewSettings
+			m.settingsDraft = m.config.Settings()
+			m.settingsCursor = 0
+			return m, nil
+		}
+	case "?":
+		// Settings has its own exit path; don't let help overwrite previousView
+		if m.view == ViewSettings {
+			break
+		}
+		// Toggle help view; skip when already showing help (handled by handleHelpKeys)
+		if m.view == ViewHelp {
 			m.view = m.previousView
 			return m, nil
 		}
@@ -493,21 +1215,261 @@ func (m Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleDetailKeys(msg)
 	case ViewHelp:
 		return m.handleHelpKeys(msg)
+	case ViewSettings:
+		return m.handleSettingsKeys(msg)
+	case ViewStats:
+		return m.handleStatsKeys(msg)
+	case ViewChangeLog:
+		return m.handleChangeLogKeys(msg)
+	}
+
+	return m, nil
+}
+
+// settingItem describes an editable entry in the settings view
+type settingItem struct {
+	label string
+	value func(config.Settings) string
+	cycle func(*config.Settings)
+}
+
+// Values offered when cycling settings
+var (
+	refreshIntervalOptions = []time.Duration{1 * time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute, 60 * time.Minute}
+	toastDurationOptions   = []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second, 10 * time.Second}
+	maxHistoryOptions      = []int{10, 20, 50, 100}
+	unitsOptions           = []string{"", model.UnitsMetric, model.UnitsImperial}
+	timezoneOptions        = []string{"", "UTC", "Europe/Amsterdam", "Europe/Berlin", "Europe/London", "America/New_York", "America/Chicago", "America/Los_Angeles", "Asia/Shanghai", "Australia/Sydney"}
+)
+
+// settingItems lists the settings shown in the settings view, in display order
+var settingItems = []settingItem{
+	{
+		label: "Auto-refresh interval",
+		value: func(s config.Settings) string { return formatInterval(s.AutoRefreshInterval) },
+		cycle: func(s *config.Settings) {
+			s.AutoRefreshInterval = nextOption(refreshIntervalOptions, s.AutoRefreshInterval)
+		},
+	},
+	{
+		label: "Max history entries",
+		value: func(s config.Settings) string { return fmt.Sprintf("%d", s.MaxHistoryEntries) },
+		cycle: func(s *config.Settings) {
+			s.MaxHistoryEntries = nextOption(maxHistoryOptions, s.MaxHistoryEntries)
+		},
+	},
+	{
+		label: "Notifications",
+		value: func(s config.Settings) string { return onOff(s.NotificationsEnabled) },
+		cycle: func(s *config.Settings) { s.NotificationsEnabled = !s.NotificationsEnabled },
+	},
+	{
+		label: "Toast duration",
+		value: func(s config.Settings) string { return s.ToastDuration.String() },
+		cycle: func(s *config.Settings) {
+			s.ToastDuration = nextOption(toastDurationOptions, s.ToastDuration)
+		},
+	},
+	{
+		label: "Timezone",
+		value: func(s config.Settings) string {
+			if s.Timezone == "" {
+				return "Not set (UTC)"
+			}
+			return s.Timezone
+		},
+		cycle: func(s *config.Settings) { s.Timezone = nextOption(timezoneOptions, s.Timezone) },
+	},
+	{
+		label: "Odometer units",
+		value: func(s config.Settings) string { return formatUnits(s.Units) },
+		cycle: func(s *config.Settings) { s.Units = nextOption(unitsOptions, s.Units) },
+	},
+	{
+		label: "Date format",
+		value: func(s config.Settings) string { return formatDateSetting(s.DateFormat) },
+		cycle: func(s *config.Settings) { s.DateFormat = nextOption(format.DateFormats, s.DateFormat) },
+	},
+	{
+		label: "Sound alert",
+		value: func(s config.Settings) string { return onOff(s.SoundAlert) },
+		cycle: func(s *config.Settings) { s.SoundAlert = !s.SoundAlert },
+	},
+}
+
+// formatDateSetting formats the date format setting with an example date
+func formatDateSetting(dateFormat string) string {
+	if dateFormat == "" {
+		dateFormat = format.DateLongUS
+	}
+	example := time.Date(2006, time.January, 2, 0, 0, 0, 0, time.UTC)
+	return fmt.Sprintf("%s (%s)", dateFormat, format.FormatDate(example, dateFormat))
+}
+
+// formatUnits formats the odometer units setting
+func formatUnits(units string) string {
+	switch units {
+	case model.UnitsMetric:
+		return "Metric (km)"
+	case model.UnitsImperial:
+		return "Imperial (mi)"
+	default:
+		return "As reported"
+	}
+}
+
+// nextOption returns the option after current, wrapping around.
+// Values not in options jump to the first option.
+func nextOption[T comparable](options []T, current T) T {
+	for i, opt := range options {
+		if opt == current {
+			return options[(i+1)%len(options)]
+		}
+	}
+	return options[0]
+}
+
+// onOff formats a boolean setting
+func onOff(b bool) string {
+	if b {
+		return "On"
 	}
+	return "Off"
+}
 
+// handleSettingsKeys handles keys in settings view
+func (m Model) handleSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.settingsCursor > 0 {
+			m.settingsCursor--
+		}
+	case "down", "j":
+		if m.settingsCursor < len(settingItems)-1 {
+			m.settingsCursor++
+		}
+	case "enter", " ":
+		settingItems[m.settingsCursor].cycle(&m.settingsDraft)
+	case "s", "S", "esc":
+		m.applySettings(m.settingsDraft)
+		m.view = m.previousView
+		return m, m.saveSettings(m.settingsDraft)
+	}
 	return m, nil
 }
 
+// applySettings updates the running model to reflect the given settings
+func (m *Model) applySettings(settings config.Settings) {
+	if settings.AutoRefreshInterval > 0 {
+		m.autoRefreshInterval = settings.AutoRefreshInterval
+	}
+	if settings.ToastDuration > 0 {
+		m.toastDuration = settings.ToastDuration
+	}
+	m.notificationsEnabled = settings.NotificationsEnabled
+	m.location = loadLocation(settings.Timezone)
+	m.units = settings.Units
+	m.soundAlert = settings.SoundAlert
+	m.watched = watchedSet(settings.WatchedOrders)
+	if settings.DateFormat != "" {
+		m.dateFormat = settings.DateFormat
+	}
+	m.history.SetMaxEntries(settings.MaxHistoryEntries)
+}
+
+// saveSettings persists settings to disk
+func (m Model) saveSettings(settings config.Settings) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.config.SaveSettings(settings); err != nil {
+			return ToastMsg{Message: "✗ Failed to save settings", IsError: true}
+		}
+		return ToastMsg{Message: "✓ Settings saved"}
+	}
+}
+
 // handleHelpKeys handles keys in help view
 func (m Model) handleHelpKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc", "?", "enter", "backspace":
 		m.view = m.previousView
 		return m, nil
+	case "+", "=":
+		m.autoRefreshInterval += time.Minute
+		return m, m.saveRefreshInterval()
+	case "-":
+		if m.autoRefreshInterval-time.Minute >= time.Minute {
+			m.autoRefreshInterval -= time.Minute
+			return m, m.saveRefreshInterval()
+		}
+	}
+	return m, nil
+}
+
+// handleStatsKeys handles keys in stats view
+func (m Model) handleStatsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "i", "enter", "backspace":
+		m.view = ViewOrders
+	}
+	return m, nil
+}
+
+// handleChangeLogKeys handles keys in change log view
+func (m Model) handleChangeLogKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "C", "enter", "backspace":
+		m.view = ViewOrders
+	case "up", "k":
+		if m.changeLogScroll > 0 {
+			m.changeLogScroll--
+		}
+	case "down", "j":
+		if m.changeLogScroll < len(m.changeLog)-1 {
+			m.changeLogScroll++
+		}
 	}
 	return m, nil
 }
 
+// saveRefreshInterval persists the current auto-refresh interval to settings
+func (m Model) saveRefreshInterval() tea.Cmd {
+	interval := m.autoRefreshInterval
+	return func() tea.Msg {
+		settings := m.config.Settings()
+		settings.AutoRefreshInterval = interval
+		if err := m.config.SaveSettings(settings); err != nil {
+			return ToastMsg{Message: "✗ Failed to save settings", IsError: true}
+		}
+		return nil
+	}
+}
+
+// detectRegion selects the API region named by the access token and saves it
+// when it differs from the saved one. The region from --region always wins.
+// The client is updated right away so the orders request that follows uses
+// the detected endpoints.
+func (m Model) detectRegion(tokens *model.TeslaTokens) tea.Cmd {
+	if m.regionOverride || tokens == nil {
+		return nil
+	}
+	region := api.DetectRegion(tokens.AccessToken)
+	if region == "" {
+		return nil
+	}
+	m.client.SetRegion(region)
+	if m.config == nil || m.config.Settings().Region == region {
+		return nil
+	}
+	return func() tea.Msg {
+		settings := m.config.Settings()
+		settings.Region = region
+		if err := m.config.SaveSettings(settings); err != nil {
+			return ToastMsg{Message: "✗ Failed to save settings", IsError: true}
+		}
+		return nil
+	}
+}
+
 // handleLoginKeys handles keys in login view
 func (m Model) handleLoginKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// If we're waiting for URL input
@@ -563,13 +1525,85 @@ func (m Model) handleOrdersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.selectedTab = TabDetails
 			m.viewport.SetContent(m.getTabContent())
 			m.viewport.GotoTop()
+			return m, m.startCountdown()
 		}
 	case "r":
 		m.loading = true
 		return m, tea.Batch(m.spinner.Tick, m.loadOrders)
+	case "ctrl+r":
+		m.loading = true
+		return m, tea.Batch(m.spinner.Tick, m.forceLoadOrders)
+	case "s":
+		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
+			// Keep the cursor on the selected order
+			ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+			m.sortMode = (m.sortMode + 1) % len(sortModeNames)
+			m.orders = sortPinned(sortOrders(m.loadedOrders, m.sortMode), m.pinned)
+			for i, order := range m.orders {
+				if order.Order.ReferenceNumber == ref {
+					m.selectedOrder = i
+					break
+				}
+			}
+		}
+		return m, nil
 	case "L":
 		m.confirmingLogout = true
 		return m, nil
+	case "P":
+		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
+			return m, m.togglePin(m.orders[m.selectedOrder].Order.ReferenceNumber)
+		}
+		return m, nil
+	case "W":
+		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
+			ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+			if m.watched == nil {
+				m.watched = make(map[string]bool)
+			}
+			if m.watched[ref] {
+				delete(m.watched, ref)
+				m.toastMessage = "✓ Order no longer watched"
+			} else {
+				m.watched[ref] = true
+				m.toastMessage = "✓ Order watched"
+			}
+			m.toastIsError = false
+			return m, tea.Batch(m.saveWatchedOrders(), m.clearToastAfterDelay())
+		}
+		return m, nil
+	case "A":
+		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
+			return m, m.toggleArchive(m.orders[m.selectedOrder].Order.ReferenceNumber)
+		}
+		return m, nil
+	case "E":
+		if len(m.orders) > 0 {
+			return m, m.exportOrders()
+		}
+		return m, nil
+	case "Z":
+		if len(m.orders) > 0 {
+			return m, m.exportAllChecklists()
+		}
+		return m, nil
+	case "C":
+		changeLog, err := m.loadChangeLog()
+		if err != nil {
+			m.toastMessage = "✗ Failed to load history: " + err.Error()
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		m.changeLog = changeLog
+		m.changeLogScroll = 0
+		m.view = ViewChangeLog
+		return m, nil
+	case "i":
+		// 'S' is taken by settings, so stats live on 'i'
+		if len(m.orders) > 0 {
+			m.view = ViewStats
+		}
+		return m, nil
 	case "y", "c":
 		// Copy VIN of selected order to clipboard
 		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
@@ -581,11 +1615,52 @@ func (m Model) handleOrdersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.toastIsError = true
 			return m, m.clearToastAfterDelay()
 		}
+	case "N":
+		// Copy the reference number, e.g. for Tesla support
+		if len(m.orders) > 0 && m.selectedOrder < len(m.orders) {
+			return m, copyToClipboard(m.orders[m.selectedOrder].Order.ReferenceNumber)
+		}
 	}
 
 	return m, nil
 }
 
+// maxChecklistUndo is how many checklist toggles ctrl+z can undo
+const maxChecklistUndo = 10
+
+// checklistUndoEntry records a checklist toggle so ctrl+z can reverse it
+type checklistUndoEntry struct {
+	ReferenceNumber string
+	ItemID          string
+	Previous        bool // checked state before the toggle
+}
+
+// pushChecklistUndo records a toggle, dropping the oldest entry when the
+// stack is full
+func (m *Model) pushChecklistUndo(entry checklistUndoEntry) {
+	m.checklistUndo = append(m.checklistUndo, entry)
+	if len(m.checklistUndo) > maxChecklistUndo {
+		m.checklistUndo = m.checklistUndo[len(m.checklistUndo)-maxChecklistUndo:]
+	}
+}
+
+// undoChecklistToggle reverses the most recent checklist toggle by toggling
+// the item again
+func (m Model) undoChecklistToggle() (tea.Model, tea.Cmd) {
+	if len(m.checklistUndo) == 0 {
+		m.toastMessage = "Nothing to undo"
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+	}
+
+	entry := m.checklistUndo[len(m.checklistUndo)-1]
+	m.checklistUndo = m.checklistUndo[:len(m.checklistUndo)-1]
+	return m, func() tea.Msg {
+		checked, err := m.checklist.ToggleItem(entry.ReferenceNumber, entry.ItemID)
+		return ChecklistToggleMsg{ReferenceNumber: entry.ReferenceNumber, ItemID: entry.ItemID, Checked: checked, Undo: true, Error: err}
+	}
+}
+
 // handleDetailKeys handles keys in detail view
 func (m Model) handleDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	const numTabs = 5 // Details, Tasks, Checklist, History, JSON
@@ -600,104 +1675,522 @@ func (m Model) handleDetailKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		case "down", "j":
-			totalItems := 0
-			for _, section := range storage.DeliveryChecklist {
-				totalItems += len(section.Items)
-			}
-			if m.checklistCursor < totalItems-1 {
+			if m.checklistCursor < len(m.checklistRows())-1 {
 				m.checklistCursor++
 				m.viewport.SetContent(m.getTabContent())
 			}
 			return m, nil
 		case "enter", " ":
+			rows := m.checklistRows()
+			if m.checklistCursor >= len(rows) {
+				return m, nil
+			}
+			row := rows[m.checklistCursor]
+			sections := m.selectedChecklist()
+			if row.item < 0 {
+				title := sections[row.section].Title
+				m.checklistExpanded[title] = !m.isSectionExpanded(title)
+				m.viewport.SetContent(m.getTabContent())
+				return m, nil
+			}
 			if m.selectedOrder < len(m.orders) {
 				ref := m.orders[m.selectedOrder].Order.ReferenceNumber
-				itemID := m.getChecklistItemAtCursor()
-				if itemID != "" {
-					return m, func() tea.Msg {
-						checked, err := m.checklist.ToggleItem(ref, itemID)
-						return ChecklistToggleMsg{ItemID: itemID, Checked: checked, Error: err}
-					}
+				itemID := sections[row.section].Items[row.item].ID
+				return m, func() tea.Msg {
+					checked, err := m.checklist.ToggleItem(ref, itemID)
+					return ChecklistToggleMsg{ReferenceNumber: ref, ItemID: itemID, Checked: checked, Error: err}
 				}
 			}
 			return m, nil
+		case "ctrl+z":
+			return m.undoChecklistToggle()
+		case "R":
+			if m.selectedOrder < len(m.orders) {
+				m.resetConfirming = true
+			}
+			return m, nil
+		case "e":
+			return m, m.exportChecklist()
 		}
 	}
 
-	switch msg.String() {
-	case "esc", "backspace":
-		m.view = ViewOrders
-		m.viewport.GotoTop()
-		return m, nil
-	case "tab":
-		m.selectedTab = Tab((int(m.selectedTab) + 1) % numTabs)
-		m.onTabSwitch()
-		m.viewport.SetContent(m.getTabContent())
-		m.viewport.GotoTop()
-		return m, nil
-	case "shift+tab":
-		if m.selectedTab == 0 {
-			m.selectedTab = TabJSON
-		} else {
-			m.selectedTab--
+	// Task detail overlay: esc closes it, other keys scroll
+	if m.selectedTab == TabTasks && m.taskDetailOverlay {
+		if msg.String() == "esc" {
+			m.taskDetailOverlay = false
+			m.focusedTask = ""
+			m.viewport.SetContent(m.getTabContent())
+			m.viewport.GotoTop()
+			return m, nil
 		}
-		m.onTabSwitch()
-		m.viewport.SetContent(m.getTabContent())
-		m.viewport.GotoTop()
-		return m, nil
-	case "r":
-		m.loading = true
-		return m, tea.Batch(m.spinner.Tick, m.loadOrders)
-	case "y", "c":
-		if m.selectedOrder < len(m.orders) {
-			if m.selectedTab == TabJSON {
-				// Copy full JSON on the JSON tab
-				return m, m.copyJSON()
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	// Tasks-specific keys
+	if m.selectedTab == TabTasks {
+		switch msg.String() {
+		case "up", "k":
+			if m.taskCursor > 0 {
+				m.taskCursor--
+				m.viewport.SetContent(m.getTabContent())
 			}
-			// Copy VIN on other tabs
-			vin := m.orders[m.selectedOrder].Order.GetVIN()
-			if vin != "" && vin != "N/A" {
-				return m, copyToClipboard(vin)
+			return m, nil
+		case "down", "j":
+			if m.selectedOrder < len(m.orders) && m.taskCursor < len(sortedTasks(m.orders[m.selectedOrder]))-1 {
+				m.taskCursor++
+				m.viewport.SetContent(m.getTabContent())
 			}
-			m.toastMessage = "No VIN available to copy"
-			m.toastIsError = true
-			return m, m.clearToastAfterDelay()
+			return m, nil
+		case "o":
+			return m, m.openTaskAction()
+		case "T":
+			if m.selectedOrder < len(m.orders) {
+				tasks := sortedTasks(m.orders[m.selectedOrder])
+				if m.taskCursor < len(tasks) {
+					m.taskDetailOverlay = true
+					m.focusedTask = tasks[m.taskCursor].name
+					m.viewport.SetContent(m.getTabContent())
+					m.viewport.GotoTop()
+				}
+			}
+			return m, nil
 		}
 	}
 
-	// Pass other keys to viewport for scrolling
-	var cmd tea.Cmd
-	m.viewport, cmd = m.viewport.Update(msg)
-	return m, cmd
-}
-
-// onTabSwitch performs setup when switching tabs
-func (m *Model) onTabSwitch() {
-	if m.selectedTab == TabChecklist && m.selectedOrder < len(m.orders) {
-		ref := m.orders[m.selectedOrder].Order.ReferenceNumber
-		state, err := m.checklist.LoadState(ref)
-		if err == nil {
-			m.checklistState = state
+	// Details field focus: review changes one at a time
+	if m.selectedTab == TabDetails && m.detailFocus {
+		switch msg.String() {
+		case "up", "k":
+			if m.detailCursor > 0 {
+				m.detailCursor--
+				m.viewport.SetContent(m.getTabContent())
+			}
+			return m, nil
+		case "down", "j":
+			if m.detailCursor < len(m.selectedDiffs())-1 {
+				m.detailCursor++
+				m.viewport.SetContent(m.getTabContent())
+			}
+			return m, nil
+		case "a":
+			return m, m.acknowledgeField()
+		case "F", "esc":
+			m.detailFocus = false
+			m.viewport.SetContent(m.getTabContent())
+			return m, nil
 		}
-		m.checklistCursor = 0
 	}
-}
-
-// getChecklistItemAtCursor returns the checklist item ID at the current cursor position
-func (m Model) getChecklistItemAtCursor() string {
-	idx := 0
-	for _, section := range storage.DeliveryChecklist {
-		for _, item := range section.Items {
-			if idx == m.checklistCursor {
-				return item.ID
-			}
-			idx++
+	// F rather than f, which the viewport uses for page down
+	if m.selectedTab == TabDetails && msg.String() == "F" {
+		if len(m.selectedDiffs()) == 0 {
+			m.toastMessage = "No changes to review"
+			m.toastIsError = false
+			return m, m.clearToastAfterDelay()
 		}
+		m.detailFocus = true
+		m.detailCursor = 0
+		m.viewport.SetContent(m.getTabContent())
+		m.viewport.GotoTop()
+		return m, nil
 	}
-	return ""
-}
 
-// openBrowserForAuth opens the browser for Tesla login
+	// Details-specific keys
+	if m.selectedTab == TabDetails && (msg.String() == "m" || msg.String() == "M") {
+		if m.selectedOrder >= len(m.orders) {
+			return m, nil
+		}
+		address := deliveryAddress(m.orders[m.selectedOrder])
+		if address == "" || address == "N/A" {
+			m.toastMessage = "No delivery address available"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		m.toastMessage = "Opening in Maps"
+		m.toastIsError = false
+		return m, tea.Batch(openMaps(address), m.clearToastAfterDelay())
+	}
+	if m.selectedTab == TabDetails && msg.String() == "O" {
+		if m.selectedOrder >= len(m.orders) {
+			return m, nil
+		}
+		url := m.orders[m.selectedOrder].GetSelfSchedulingURL()
+		if url == "" {
+			m.toastMessage = "No self-scheduling link available"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		return m, openSchedulingURL(url)
+	}
+	if m.selectedTab == TabDetails && msg.String() == "D" {
+		if m.selectedOrder >= len(m.orders) {
+			return m, nil
+		}
+		address := deliveryAddress(m.orders[m.selectedOrder])
+		if address == "" || address == "N/A" {
+			m.toastMessage = "No delivery address available"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		mapsLink := mapsURL("", address)
+		return m, func() tea.Msg {
+			if err := browser.OpenURL(mapsLink); err != nil {
+				return ToastMsg{Message: "✗ Failed to open browser", IsError: true}
+			}
+			return ToastMsg{Message: "Opening delivery location in Google Maps"}
+		}
+	}
+	if m.selectedTab == TabDetails && msg.String() == "I" {
+		if m.selectedOrder >= len(m.orders) {
+			return m, nil
+		}
+		return m, m.copyICS(m.orders[m.selectedOrder])
+	}
+	if m.selectedTab == TabDetails && msg.String() == "u" {
+		if m.units == model.UnitsImperial {
+			m.units = model.UnitsMetric
+		} else {
+			m.units = model.UnitsImperial
+		}
+		m.viewport.SetContent(m.getTabContent())
+		m.toastMessage = "Odometer units: " + formatUnits(m.units)
+		m.toastIsError = false
+		return m, m.clearToastAfterDelay()
+	}
+
+	// JSON-specific keys
+	if m.selectedTab == TabJSON {
+		switch msg.String() {
+		case "/":
+			m.jsonSearching = true
+			m.searchInput.SetValue(m.jsonSearchQuery)
+			m.searchInput.CursorEnd()
+			return m, m.searchInput.Focus()
+		case "n", "N":
+			if len(m.jsonSearchMatches) == 0 {
+				return m, nil
+			}
+			step := 1
+			if msg.String() == "N" {
+				step = len(m.jsonSearchMatches) - 1
+			}
+			m.jsonSearchIndex = (m.jsonSearchIndex + step) % len(m.jsonSearchMatches)
+			m.scrollToJSONMatch()
+			return m, nil
+		case "esc":
+			if m.jsonSearchQuery != "" {
+				m.clearJSONSearch()
+				m.viewport.SetContent(m.getTabContent())
+				return m, nil
+			}
+		case "v":
+			m.rawViewMode = !m.rawViewMode
+			m.clearJSONSearch()
+			m.jsonScrollX = 0
+			m.viewport.SetXOffset(0)
+			m.viewport.SetContent(m.getTabContent())
+			m.viewport.GotoTop()
+			return m, nil
+		case "left", "h":
+			m.scrollJSONHorizontally(-jsonScrollStep)
+			return m, nil
+		case "right", "l":
+			m.scrollJSONHorizontally(jsonScrollStep)
+			return m, nil
+		}
+	}
+
+	// History-specific keys
+	if m.selectedTab == TabHistory && (msg.String() == "n" || msg.String() == "p") {
+		if m.selectedOrder >= len(m.orders) {
+			return m, nil
+		}
+		history, err := m.loadOrderHistory(m.orders[m.selectedOrder])
+		if err != nil {
+			return m, nil
+		}
+		next, ok := nextChangeIndex(history, m.historyIndex, msg.String() == "n")
+		if !ok {
+			m.toastMessage = "No more changes"
+			m.toastIsError = false
+			return m, m.clearToastAfterDelay()
+		}
+		m.historyIndex = next
+		content, focusLine := m.renderHistory(m.orders[m.selectedOrder])
+		m.viewport.SetContent(content)
+		m.viewport.SetYOffset(focusLine)
+		return m, nil
+	}
+	if m.selectedTab == TabHistory && msg.String() == "C" {
+		return m, m.exportHistoryCSV()
+	}
+	if m.selectedTab == TabHistory && msg.String() == "d" {
+		if m.selectedOrder < len(m.orders) {
+			m.deleteConfirming = true
+			m.toastMessage = "Delete history for this order? y/n"
+			m.toastIsError = true
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc", "backspace":
+		m.view = ViewOrders
+		m.detailFocus = false
+		m.viewport.GotoTop()
+		return m, nil
+	case "tab":
+		m.selectedTab = Tab((int(m.selectedTab) + 1) % numTabs)
+		m.onTabSwitch()
+		m.viewport.SetContent(m.getTabContent())
+		m.viewport.GotoTop()
+		return m, nil
+	case "shift+tab":
+		if m.selectedTab == 0 {
+			m.selectedTab = TabJSON
+		} else {
+			m.selectedTab--
+		}
+		m.onTabSwitch()
+		m.viewport.SetContent(m.getTabContent())
+		m.viewport.GotoTop()
+		return m, nil
+	case "1", "2", "3", "4", "5":
+		if tab, ok := tabForKey(msg.String()); ok && tab != m.selectedTab {
+			m.selectedTab = tab
+			m.onTabSwitch()
+			m.viewport.SetContent(m.getTabContent())
+			m.viewport.GotoTop()
+		}
+		return m, nil
+	case "r":
+		m.loading = true
+		return m, tea.Batch(m.spinner.Tick, m.loadOrders)
+	case "ctrl+r":
+		m.loading = true
+		return m, tea.Batch(m.spinner.Tick, m.forceLoadOrders)
+	case "a":
+		return m, m.acknowledgeChanges()
+	case "y", "c":
+		if m.selectedOrder < len(m.orders) {
+			if m.selectedTab == TabJSON {
+				// Copy full JSON on the JSON tab
+				return m, m.copyJSON()
+			}
+			// Copy VIN on other tabs
+			vin := m.orders[m.selectedOrder].Order.GetVIN()
+			if vin != "" && vin != "N/A" {
+				return m, copyToClipboard(vin)
+			}
+			m.toastMessage = "No VIN available to copy"
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+	}
+
+	// Pass other keys to viewport for scrolling
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// handleJSONSearchKeys handles keys while the JSON search bar has focus.
+// Matches update as the query is typed; enter keeps the search, esc clears it.
+func (m Model) handleJSONSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "enter":
+		m.jsonSearching = false
+		m.searchInput.Blur()
+		if len(m.jsonSearchMatches) == 0 && m.jsonSearchQuery != "" {
+			m.toastMessage = "No matches for \"" + m.jsonSearchQuery + "\""
+			m.toastIsError = true
+			return m, m.clearToastAfterDelay()
+		}
+		return m, nil
+	case "esc":
+		m.clearJSONSearch()
+		m.viewport.SetContent(m.getTabContent())
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if query := m.searchInput.Value(); query != m.jsonSearchQuery {
+		m.setJSONSearchQuery(query)
+	}
+	return m, cmd
+}
+
+// setJSONSearchQuery updates the JSON tab search and jumps to the first match
+func (m *Model) setJSONSearchQuery(query string) {
+	m.jsonSearchQuery = query
+	m.jsonSearchMatches = nil
+	m.jsonSearchIndex = 0
+	if m.selectedOrder < len(m.orders) {
+		if text, err := m.jsonText(m.orders[m.selectedOrder]); err == nil {
+			m.jsonSearchMatches = findJSONMatches(text, query)
+		}
+	}
+	m.viewport.SetContent(m.getTabContent())
+	m.scrollToJSONMatch()
+}
+
+// jsonScrollStep is how many columns left/right scroll the JSON tab
+const jsonScrollStep = 8
+
+// jsonOverflow returns how many columns the JSON tab is wider than the viewport
+func (m Model) jsonOverflow() int {
+	if m.selectedTab != TabJSON {
+		return 0
+	}
+	return max(lipgloss.Width(m.getTabContent())-m.viewport.Width, 0)
+}
+
+// scrollJSONHorizontally shifts the JSON tab by delta columns, staying within
+// the widest line
+func (m *Model) scrollJSONHorizontally(delta int) {
+	m.jsonScrollX = min(max(m.jsonScrollX+delta, 0), m.jsonOverflow())
+	m.viewport.SetXOffset(m.jsonScrollX)
+}
+
+// clearJSONSearch closes the search bar and removes the query
+func (m *Model) clearJSONSearch() {
+	m.jsonSearching = false
+	m.searchInput.Blur()
+	m.searchInput.SetValue("")
+	m.jsonSearchQuery = ""
+	m.jsonSearchMatches = nil
+	m.jsonSearchIndex = 0
+}
+
+// scrollToJSONMatch scrolls the viewport so the current match is visible
+func (m *Model) scrollToJSONMatch() {
+	if m.jsonSearchIndex >= len(m.jsonSearchMatches) {
+		return
+	}
+	line := m.jsonSearchMatches[m.jsonSearchIndex]
+	// The search bar takes one line from the viewport
+	visible := m.viewport.Height - 1
+	if line < m.viewport.YOffset || line >= m.viewport.YOffset+visible {
+		offset := line - visible/2
+		if offset < 0 {
+			offset = 0
+		}
+		m.viewport.SetYOffset(offset)
+	}
+}
+
+// findJSONMatches returns the numbers of the lines in content that contain
+// query, ignoring case
+func findJSONMatches(content, query string) []int {
+	if query == "" {
+		return nil
+	}
+	query = strings.ToLower(query)
+	var matches []int
+	for i, line := range strings.Split(content, "\n") {
+		if strings.Contains(strings.ToLower(line), query) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// highlightSearchMatches wraps every case-insensitive occurrence of query in
+// line with SearchMatchStyle
+func highlightSearchMatches(line, query string) string {
+	lower := strings.ToLower(line)
+	query = strings.ToLower(query)
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 {
+			b.WriteString(line)
+			return b.String()
+		}
+		b.WriteString(line[:i])
+		b.WriteString(SearchMatchStyle.Render(line[i : i+len(query)]))
+		line, lower = line[i+len(query):], lower[i+len(query):]
+	}
+}
+
+// renderJSONSearchBar renders the search bar shown below the tabs
+func (m Model) renderJSONSearchBar() string {
+	if m.jsonSearching {
+		return m.searchInput.View()
+	}
+	status := "no matches"
+	if n := len(m.jsonSearchMatches); n > 0 {
+		status = fmt.Sprintf("%d/%d", m.jsonSearchIndex+1, n)
+	}
+	return ValueStyle.Render("/"+m.jsonSearchQuery) + lipgloss.NewStyle().Foreground(Muted).Render("  ("+status+") • esc: clear")
+}
+
+// onTabSwitch performs setup when switching tabs
+func (m *Model) onTabSwitch() {
+	m.detailFocus = false
+	m.clearJSONSearch()
+	m.jsonScrollX = 0
+	m.viewport.SetXOffset(0)
+	if m.selectedTab == TabHistory {
+		m.historyIndex = -1
+	}
+	if m.selectedTab == TabTasks {
+		m.taskCursor = 0
+	}
+	m.taskDetailOverlay = false
+	m.focusedTask = ""
+	if m.selectedTab == TabChecklist && m.selectedOrder < len(m.orders) {
+		ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+		state, err := m.checklist.LoadState(ref)
+		if err == nil {
+			m.checklistState = state
+		}
+		m.checklistCursor = 0
+	}
+}
+
+// checklistRow identifies a visible row in the checklist tab.
+// item is -1 for a section header.
+type checklistRow struct {
+	section int
+	item    int
+}
+
+// isSectionExpanded reports whether a checklist section is expanded (the default)
+func (m Model) isSectionExpanded(title string) bool {
+	expanded, ok := m.checklistExpanded[title]
+	return !ok || expanded
+}
+
+// selectedChecklist returns the delivery checklist for the selected order,
+// including the sections specific to its model
+func (m Model) selectedChecklist() []storage.ChecklistSection {
+	if m.selectedOrder < len(m.orders) {
+		return storage.ChecklistFor(m.orders[m.selectedOrder].Order.ModelCode)
+	}
+	return storage.DeliveryChecklist
+}
+
+// checklistRows returns the cursor-selectable rows, skipping items in collapsed sections
+func (m Model) checklistRows() []checklistRow {
+	var rows []checklistRow
+	for si, section := range m.selectedChecklist() {
+		rows = append(rows, checklistRow{section: si, item: -1})
+		if !m.isSectionExpanded(section.Title) {
+			continue
+		}
+		for ii := range section.Items {
+			rows = append(rows, checklistRow{section: si, item: ii})
+		}
+	}
+	return rows
+}
+
+// openBrowserForAuth opens the browser for Tesla login
 func (m Model) openBrowserForAuth() tea.Msg {
 	session, err := m.client.Auth().CreateAuthSession()
 	if err != nil {
@@ -765,95 +2258,721 @@ func extractCodeFromURL(callbackURL string) (string, error) {
 
 // loadOrders loads orders from the API
 func (m Model) loadOrders() tea.Msg {
-	orders, err := m.client.GetAllOrderData()
+	return m.fetchOrders(m.client.GetAllOrderData)
+}
+
+// forceLoadOrders loads orders from the API without waiting for the rate
+// limiter
+func (m Model) forceLoadOrders() tea.Msg {
+	return m.fetchOrders(m.client.ForceGetAllOrderData)
+}
+
+// fetchOrders loads orders with fetch, records their history and detects
+// changes
+func (m Model) fetchOrders(fetch func(api.ProgressFunc) ([]model.CombinedOrder, error)) tea.Msg {
+	orders, err := fetch(m.reportProgress)
 	if err != nil {
+		if api.IsNetworkError(err) {
+			return m.loadCachedOrders(err)
+		}
 		return OrdersLoadedMsg{Error: err}
 	}
 
 	// Check for changes and store history
 	diffs := make(map[string][]model.OrderDiff)
+	newDiffs := make(map[string][]model.OrderDiff)
 	for _, order := range orders {
 		orderDiffs, err := m.history.AddSnapshot(order)
 		if err != nil {
 			// Log but don't fail
+			m.logger.Error("history snapshot", "reference", order.Order.ReferenceNumber, "error", err.Error())
 			continue
 		}
+		if len(orderDiffs) > 0 {
+			newDiffs[order.Order.ReferenceNumber] = orderDiffs
+			fields := make([]string, 0, len(orderDiffs))
+			for _, d := range orderDiffs {
+				fields = append(fields, d.Field)
+			}
+			m.logger.Info("changes detected",
+				"reference", order.Order.ReferenceNumber,
+				"count", len(orderDiffs),
+				"fields", fields,
+			)
+		}
+		if m.acks != nil {
+			// Keep changes visible until acknowledged, even across sessions
+			pending, err := m.acks.Record(order.Order.ReferenceNumber, orderDiffs)
+			if err != nil {
+				m.logger.Error("acknowledgements", "reference", order.Order.ReferenceNumber, "error", err.Error())
+			} else {
+				orderDiffs = pending
+			}
+		}
 		if len(orderDiffs) > 0 {
 			diffs[order.Order.ReferenceNumber] = orderDiffs
 		}
 	}
-
-	return OrdersLoadedMsg{Orders: orders, Diffs: diffs}
+
+	orders = m.filterArchived(orders)
+	return OrdersLoadedMsg{Orders: orders, Diffs: diffs, NewDiffs: newDiffs, Timelines: m.buildTimelines(orders)}
+}
+
+// loadWatchedOrders refreshes the orders for auto-refresh. When orders are
+// watched with 'W', only those (and orders not shown yet) have their details
+// fetched; the others keep their current data, so they record no history and
+// raise no change alerts.
+func (m Model) loadWatchedOrders() tea.Msg {
+	if len(m.watched) == 0 {
+		return m.loadOrders()
+	}
+	known := make(map[string]bool, len(m.orders))
+	for _, order := range m.orders {
+		known[order.Order.ReferenceNumber] = true
+	}
+	include := func(ref string) bool {
+		return m.watched[ref] || !known[ref]
+	}
+	return m.fetchOrders(func(progress api.ProgressFunc) ([]model.CombinedOrder, error) {
+		orders, err := m.client.GetOrderDataFor(include, progress)
+		if err != nil {
+			return nil, err
+		}
+		return mergeWatched(m.orders, orders, m.watched), nil
+	})
+}
+
+// mergeWatched returns the fetched orders with every order that is not
+// watched replaced by its current version. Orders that are not shown yet are
+// taken from fetched.
+func mergeWatched(current, fetched []model.CombinedOrder, watched map[string]bool) []model.CombinedOrder {
+	byRef := make(map[string]model.CombinedOrder, len(current))
+	for _, order := range current {
+		byRef[order.Order.ReferenceNumber] = order
+	}
+
+	merged := make([]model.CombinedOrder, 0, len(fetched))
+	for _, order := range fetched {
+		ref := order.Order.ReferenceNumber
+		if old, ok := byRef[ref]; ok && !watched[ref] {
+			order = old
+		}
+		merged = append(merged, order)
+	}
+	return merged
+}
+
+// watchedSet turns the watched reference numbers from settings into a set
+func watchedSet(refs []string) map[string]bool {
+	watched := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		watched[ref] = true
+	}
+	return watched
+}
+
+// saveWatchedOrders persists the watched reference numbers to settings
+func (m Model) saveWatchedOrders() tea.Cmd {
+	if m.config == nil || m.demoMode {
+		return nil
+	}
+	refs := make([]string, 0, len(m.watched))
+	for ref := range m.watched {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return func() tea.Msg {
+		settings := m.config.Settings()
+		settings.WatchedOrders = refs
+		if err := m.config.SaveSettings(settings); err != nil {
+			return ToastMsg{Message: "✗ Failed to save watched orders", IsError: true}
+		}
+		return nil
+	}
+}
+
+// loadCachedOrders falls back to the latest history snapshot of each known
+// order when the API can't be reached
+func (m Model) loadCachedOrders(networkErr error) tea.Msg {
+	m.logger.Warn("network unavailable, using cached orders", "error", networkErr.Error())
+
+	orders, err := m.history.LatestSnapshots()
+	if err != nil {
+		m.logger.Error("cached orders", "error", err.Error())
+		return OrdersLoadedMsg{Error: networkErr}
+	}
+	if len(orders) == 0 {
+		return OrdersLoadedMsg{Error: networkErr}
+	}
+
+	orders = m.filterArchived(orders)
+	return OrdersLoadedMsg{Orders: orders, Timelines: m.buildTimelines(orders), Offline: true}
+}
+
+// filterArchived removes archived orders unless archived orders are shown
+func (m Model) filterArchived(orders []model.CombinedOrder) []model.CombinedOrder {
+	if m.archive == nil || m.showArchived {
+		return orders
+	}
+
+	archived, err := m.archive.Load()
+	if err != nil {
+		m.logger.Error("archive", "error", err.Error())
+		return orders
+	}
+
+	visible := make([]model.CombinedOrder, 0, len(orders))
+	for _, order := range orders {
+		if !archived[order.Order.ReferenceNumber] {
+			visible = append(visible, order)
+		}
+	}
+	return visible
+}
+
+// toggleArchive archives or unarchives an order. In demo mode nothing is
+// persisted and the order is simply hidden.
+func (m Model) toggleArchive(ref string) tea.Cmd {
+	if m.archive == nil || m.demoMode {
+		return func() tea.Msg {
+			return ArchiveToggledMsg{ReferenceNumber: ref, Archived: true}
+		}
+	}
+	return func() tea.Msg {
+		archived, err := m.archive.Toggle(ref)
+		return ArchiveToggledMsg{ReferenceNumber: ref, Archived: archived, Error: err}
+	}
+}
+
+// togglePin pins or unpins an order. In demo mode nothing is persisted.
+func (m Model) togglePin(ref string) tea.Cmd {
+	if m.pins == nil || m.demoMode {
+		pinned := !m.pinned[ref]
+		return func() tea.Msg {
+			return PinToggledMsg{ReferenceNumber: ref, Pinned: pinned}
+		}
+	}
+	return func() tea.Msg {
+		pinned, err := m.pins.Toggle(ref)
+		return PinToggledMsg{ReferenceNumber: ref, Pinned: pinned, Error: err}
+	}
+}
+
+// Orders list sort modes, cycled in this order with 's'
+const (
+	sortDefault = iota // as returned by the API
+	sortDeliveryDate
+	sortModel
+	sortStatus
+	sortReference
+)
+
+// sortModeNames describes each sort mode in the footer
+var sortModeNames = []string{
+	sortDefault:      "default",
+	sortDeliveryDate: "delivery date",
+	sortModel:        "model",
+	sortStatus:       "status",
+	sortReference:    "reference",
+}
+
+// sortOrders returns a copy of orders sorted by mode. The sort is stable,
+// so orders that compare equal keep their original order.
+func sortOrders(orders []model.CombinedOrder, mode int) []model.CombinedOrder {
+	sorted := make([]model.CombinedOrder, len(orders))
+	copy(sorted, orders)
+
+	var less func(a, b *model.CombinedOrder) bool
+	switch mode {
+	case sortDeliveryDate:
+		// Earliest first; orders without a known date go last
+		less = func(a, b *model.CombinedOrder) bool {
+			ta, okA := deliveryDate(a)
+			tb, okB := deliveryDate(b)
+			if okA != okB {
+				return okA
+			}
+			return ta.Before(tb)
+		}
+	case sortModel:
+		less = func(a, b *model.CombinedOrder) bool {
+			return a.Order.GetModelName() < b.Order.GetModelName()
+		}
+	case sortStatus:
+		less = func(a, b *model.CombinedOrder) bool {
+			return strings.ToLower(a.Order.OrderStatus) < strings.ToLower(b.Order.OrderStatus)
+		}
+	case sortReference:
+		less = func(a, b *model.CombinedOrder) bool {
+			return a.Order.ReferenceNumber < b.Order.ReferenceNumber
+		}
+	default:
+		return sorted
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(&sorted[i], &sorted[j])
+	})
+	return sorted
+}
+
+// deliveryDate returns the delivery appointment of an order, or the start
+// of its delivery window when there is no appointment yet
+func deliveryDate(order *model.CombinedOrder) (time.Time, bool) {
+	if t, ok := model.ParseAppointmentTime(order.GetParsedAppointment()); ok {
+		return t, true
+	}
+
+	// Windows look like "May - Jun 2026" or "Dec 2026 - Jan 2027"
+	window := order.GetDeliveryWindow()
+	start, _, _ := strings.Cut(window, " - ")
+	start = strings.TrimSpace(start)
+	if t, err := time.Parse("Jan 2006", start); err == nil {
+		return t, true
+	}
+	fields := strings.Fields(window)
+	if len(fields) > 0 {
+		if t, err := time.Parse("Jan 2006", start+" "+fields[len(fields)-1]); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// removeOrder returns orders without the order with the given reference
+// number
+func removeOrder(orders []model.CombinedOrder, ref string) []model.CombinedOrder {
+	for i, order := range orders {
+		if order.Order.ReferenceNumber == ref {
+			return append(orders[:i:i], orders[i+1:]...)
+		}
+	}
+	return orders
+}
+
+// sortPinned moves pinned orders to the front, keeping the original relative
+// order within pinned and unpinned orders
+func sortPinned(orders []model.CombinedOrder, pinned map[string]bool) []model.CombinedOrder {
+	sorted := make([]model.CombinedOrder, len(orders))
+	copy(sorted, orders)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return pinned[sorted[i].Order.ReferenceNumber] && !pinned[sorted[j].Order.ReferenceNumber]
+	})
+	return sorted
+}
+
+// resetChecklist clears all checked items for the selected order
+func (m Model) resetChecklist() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+	return func() tea.Msg {
+		return ChecklistResetMsg{Error: m.checklist.ResetState(ref)}
+	}
+}
+
+// exportChecklist writes the selected order's checklist to a plain-text file
+func (m Model) exportChecklist() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	order := m.orders[m.selectedOrder]
+	return func() tea.Msg {
+		state, err := m.checklist.LoadState(order.Order.ReferenceNumber)
+		if err != nil {
+			return ToastMsg{Message: "✗ Failed to load checklist", IsError: true}
+		}
+		fileName := export.ChecklistFileName(order.Order.ReferenceNumber)
+		if err := os.WriteFile(fileName, []byte(export.ExportChecklist(order, state)), 0644); err != nil {
+			return ToastMsg{Message: "✗ Failed to export checklist", IsError: true}
+		}
+		return ToastMsg{Message: "✓ Exported: " + fileName}
+	}
+}
+
+// exportHistoryCSV writes the changes in the selected order's history to a
+// CSV file in the current directory
+func (m Model) exportHistoryCSV() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	order := m.orders[m.selectedOrder]
+	return func() tea.Msg {
+		history, err := m.loadOrderHistory(order)
+		if err != nil {
+			return ToastMsg{Message: "✗ Failed to load history", IsError: true}
+		}
+		data, err := export.ExportHistoryCSV(history)
+		if err != nil {
+			return ToastMsg{Message: "✗ Failed to export history", IsError: true}
+		}
+		fileName := export.HistoryCSVFileName(order.Order.ReferenceNumber)
+		if err := os.WriteFile(fileName, []byte(data), 0644); err != nil {
+			return ToastMsg{Message: "✗ Failed to export history", IsError: true}
+		}
+		return ToastMsg{Message: "✓ Exported: " + fileName}
+	}
+}
+
+// exportOrders writes all orders to a dated JSON file in the current directory
+func (m Model) exportOrders() tea.Cmd {
+	orders := m.orders
+	return func() tea.Msg {
+		data, err := export.ExportOrders(orders)
+		if err != nil {
+			return ToastMsg{Message: "✗ Failed to export orders", IsError: true}
+		}
+		fileName := export.OrdersFileName()
+		if err := os.WriteFile(fileName, []byte(data), 0644); err != nil {
+			return ToastMsg{Message: "✗ Failed to export orders", IsError: true}
+		}
+		return ToastMsg{Message: "✓ Exported: " + fileName}
+	}
+}
+
+// exportAllChecklists writes the checklists of all orders to a dated ZIP
+// file in the current directory
+func (m Model) exportAllChecklists() tea.Cmd {
+	if m.config == nil {
+		return nil
+	}
+	refs := make([]string, len(m.orders))
+	for i, order := range m.orders {
+		refs[i] = order.Order.ReferenceNumber
+	}
+	baseDir := m.config.ConfigDir()
+	return func() tea.Msg {
+		data, err := export.ExportAllChecklists(baseDir, refs)
+		if err != nil {
+			return ToastMsg{Message: "✗ Failed to export checklists", IsError: true}
+		}
+		fileName := export.ChecklistsZipFileName()
+		if err := os.WriteFile(fileName, data, 0644); err != nil {
+			return ToastMsg{Message: "✗ Failed to export checklists", IsError: true}
+		}
+		return ToastMsg{Message: "✓ Exported: " + fileName}
+	}
+}
+
+// deleteHistory removes the stored history for the selected order
+func (m Model) deleteHistory() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+	if m.demoMode {
+		delete(m.demoHistory, ref)
+		return func() tea.Msg {
+			return HistoryDeletedMsg{ReferenceNumber: ref}
+		}
+	}
+	return func() tea.Msg {
+		err := m.history.DeleteHistory(ref)
+		return HistoryDeletedMsg{ReferenceNumber: ref, Error: err}
+	}
+}
+
+// selectedDiffs returns the unacknowledged changes of the selected order
+func (m Model) selectedDiffs() []model.OrderDiff {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	return m.diffs[m.orders[m.selectedOrder].Order.ReferenceNumber]
+}
+
+// acknowledgeField marks the change under the Details field cursor as seen
+func (m Model) acknowledgeField() tea.Cmd {
+	diffs := m.selectedDiffs()
+	if m.detailCursor >= len(diffs) {
+		return nil
+	}
+	ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+	field := diffs[m.detailCursor].Field
+	if m.demoMode || m.acks == nil {
+		return func() tea.Msg {
+			return FieldAcknowledgedMsg{ReferenceNumber: ref, Field: field}
+		}
+	}
+	return func() tea.Msg {
+		return FieldAcknowledgedMsg{ReferenceNumber: ref, Field: field, Error: m.acks.AcknowledgeField(ref, field)}
+	}
+}
+
+// acknowledgeChanges dismisses the change notifications for the selected order
+func (m Model) acknowledgeChanges() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	ref := m.orders[m.selectedOrder].Order.ReferenceNumber
+	if _, ok := m.diffs[ref]; !ok {
+		return nil
+	}
+	if m.demoMode || m.acks == nil {
+		return func() tea.Msg {
+			return ChangesAcknowledgedMsg{ReferenceNumber: ref}
+		}
+	}
+	return func() tea.Msg {
+		return ChangesAcknowledgedMsg{ReferenceNumber: ref, Error: m.acks.AcknowledgeAll(ref)}
+	}
+}
+
+// tokenWatchInterval is how often the saved tokens are checked for removal
+const tokenWatchInterval = 5 * time.Second
+
+// watchTokens schedules the next check of the saved tokens
+func watchTokens() tea.Cmd {
+	return tea.Tick(tokenWatchInterval, func(t time.Time) tea.Msg {
+		return TokenWatchTickMsg(t)
+	})
+}
+
+// checkTokensSaved ends the session when the saved tokens are gone. It
+// runs as a command because the keyring lookup can block.
+func (m Model) checkTokensSaved() tea.Msg {
+	if !m.config.TokensDeleted() {
+		return nil
+	}
+	return LogoutMsg{Reason: "Session ended – please login again"}
+}
+
+// logout logs out the user
+func (m Model) logout() tea.Msg {
+	if err := m.config.DeleteTokens(); err != nil {
+		return ErrMsg{err}
+	}
+	return LogoutMsg{}
+}
+
+// clearToastAfterDelay returns a command that clears the toast after the
+// configured toast duration
+func (m Model) clearToastAfterDelay() tea.Cmd {
+	return tea.Tick(m.toastDuration, func(t time.Time) tea.Msg {
+		return ClearToastMsg{}
+	})
+}
+
+// countdownTickCmd fires a CountdownTickMsg after one second
+func countdownTickCmd() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return CountdownTickMsg(t)
+	})
+}
+
+// countdownActive reports whether the live countdown should tick: in watch
+// mode, with the detail view open on an order whose appointment is upcoming
+func (m Model) countdownActive() bool {
+	if !m.autoRefresh || m.view != ViewDetail || m.selectedOrder >= len(m.orders) {
+		return false
+	}
+	target, ok := m.countdownTarget(m.orders[m.selectedOrder])
+	return ok && target.After(timeNow())
+}
+
+// startCountdown starts the per-second countdown ticker unless it is
+// already running or there is nothing to count down to
+func (m *Model) startCountdown() tea.Cmd {
+	if !m.countdownTick.IsZero() || !m.countdownActive() {
+		return nil
+	}
+	m.countdownTick = timeNow()
+	return countdownTickCmd()
+}
+
+// scheduleAutoRefresh schedules the next auto-refresh tick
+func (m Model) scheduleAutoRefresh() tea.Cmd {
+	return tea.Tick(m.autoRefreshInterval, func(t time.Time) tea.Msg {
+		return AutoRefreshTickMsg(t)
+	})
+}
+
+// copyJSON copies the JSON shown in the JSON tab to the clipboard
+func (m Model) copyJSON() tea.Cmd {
+	if m.selectedOrder >= len(m.orders) {
+		return nil
+	}
+	text, err := m.jsonText(m.orders[m.selectedOrder])
+	if err != nil {
+		return func() tea.Msg {
+			return ClipboardMsg{Text: "JSON", Success: false, Error: err}
+		}
+	}
+	return copyToClipboard(text)
+}
+
+// copyICS copies the delivery appointment of an order to the clipboard as an
+// iCalendar event
+func (m Model) copyICS(order model.CombinedOrder) tea.Cmd {
+	ics, err := export.GenerateICS(order, m.location)
+	if err != nil {
+		return func() tea.Msg {
+			return ToastMsg{Message: "✗ No delivery appointment to export", IsError: true}
+		}
+	}
+
+	copyCmd := copyToClipboard(ics)
+	return func() tea.Msg {
+		if result, ok := copyCmd().(ClipboardMsg); ok && !result.Success {
+			return ToastMsg{Message: "✗ Failed to copy to clipboard", IsError: true}
+		}
+		return ToastMsg{Message: "✓ ICS copied to clipboard"}
+	}
+}
+
+// playAlert plays the sound alert; swapped out in tests
+var playAlert = terminalBell
+
+// terminalBell rings the terminal bell by writing the BEL character
+func terminalBell() tea.Cmd {
+	return func() tea.Msg {
+		fmt.Fprint(os.Stdout, "\a")
+		return nil
+	}
+}
+
+// hasCriticalChange reports whether any order was assigned a VIN or had a
+// delivery appointment booked
+func hasCriticalChange(diffs map[string][]model.OrderDiff) bool {
+	for _, orderDiffs := range diffs {
+		for _, diff := range orderDiffs {
+			if diff.Field != "VIN" && diff.Field != "Delivery Appointment" {
+				continue
+			}
+			if newValue, _ := diff.NewValue.(string); newValue != "" && newValue != "N/A" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// copyToClipboard copies text to the clipboard; swapped out in tests
+var copyToClipboard = systemClipboard
+
+// errNoClipboardTool is returned on Linux when no clipboard command is installed
+var errNoClipboardTool = errors.New("no clipboard tool found (install xclip, xsel, or wl-clipboard)")
+
+// lookPath finds clipboard commands; swapped out in tests
+var lookPath = exec.LookPath
+
+// linuxClipboardTools are the Linux clipboard commands, in order of preference
+var linuxClipboardTools = [][]string{
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+	{"wl-copy"},
+}
+
+// clipboardCommand returns the command that copies stdin to the clipboard
+func clipboardCommand(goos string) (*exec.Cmd, error) {
+	switch goos {
+	case "darwin":
+		return exec.Command("pbcopy"), nil
+	case "linux":
+		for _, tool := range linuxClipboardTools {
+			if path, err := lookPath(tool[0]); err == nil {
+				return exec.Command(path, tool[1:]...), nil
+			}
+		}
+		return nil, errNoClipboardTool
+	}
+	return nil, fmt.Errorf("unsupported platform")
+}
+
+// systemClipboard copies text to the system clipboard using platform-native tools
+func systemClipboard(text string) tea.Cmd {
+	return func() tea.Msg {
+		cmd, err := clipboardCommand(runtime.GOOS)
+		if err != nil {
+			return ClipboardMsg{Text: text, Success: false, Error: err}
+		}
+
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			if errors.Is(err, exec.ErrNotFound) {
+				err = errNoClipboardTool
+			}
+			return ClipboardMsg{Text: text, Success: false, Error: err}
+		}
+		return ClipboardMsg{Text: text, Success: true}
+	}
 }
 
-// logout logs out the user
-func (m Model) logout() tea.Msg {
-	if err := m.config.DeleteTokens(); err != nil {
-		return ErrMsg{err}
+// mapsURL builds the URL that opens an address in the platform's maps
+// application: Apple Maps on darwin, Google Maps elsewhere (and for any
+// other goos, such as "" when opening the browser)
+func mapsURL(goos, address string) string {
+	if goos == "darwin" {
+		return "maps://maps.apple.com/?address=" + url.QueryEscape(address)
 	}
-	return LogoutMsg{}
+	return "https://maps.google.com/?q=" + url.QueryEscape(address)
 }
 
-// clearToastAfterDelay returns a command that clears the toast after 3 seconds
-func (m Model) clearToastAfterDelay() tea.Cmd {
-	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
-		return ClearToastMsg{}
-	})
+// deliveryAddress returns the best known address for an order's delivery
+// location: the appointment address, or else the delivery center name
+func deliveryAddress(order model.CombinedOrder) string {
+	if appt := order.GetParsedAppointment(); appt != nil && appt.Address != "" {
+		return appt.Address
+	}
+	return order.GetDeliveryCenter()
 }
 
-// scheduleAutoRefresh schedules the next auto-refresh tick
-func (m Model) scheduleAutoRefresh() tea.Cmd {
-	return tea.Tick(m.autoRefreshInterval, func(t time.Time) tea.Msg {
-		return AutoRefreshTickMsg(t)
-	})
+// openSchedulingURL opens the self-scheduling page in the browser
+func openSchedulingURL(url string) tea.Cmd {
+	return func() tea.Msg {
+		if err := browser.OpenURL(url); err != nil {
+			return ToastMsg{Message: "✗ Failed to open browser", IsError: true}
+		}
+		return ToastMsg{Message: "Opening delivery scheduling"}
+	}
 }
 
-// copyJSON copies the full JSON of the selected order to the clipboard
-func (m Model) copyJSON() tea.Cmd {
+// openTaskAction opens the action link of the focused task in the browser
+func (m Model) openTaskAction() tea.Cmd {
 	if m.selectedOrder >= len(m.orders) {
 		return nil
 	}
 	order := m.orders[m.selectedOrder]
-	combined := map[string]interface{}{
-		"order": order.Order,
-	}
-	if order.Details.RawJSON != nil {
-		combined["details"] = order.Details.RawJSON
-	} else {
-		combined["details"] = order.Details
+	tasks := sortedTasks(order)
+	if m.taskCursor >= len(tasks) {
+		return nil
 	}
-	jsonBytes, err := json.MarshalIndent(combined, "", "  ")
-	if err != nil {
+
+	var task model.TeslaTask
+	if err := json.Unmarshal(order.Details.Tasks.Raw[tasks[m.taskCursor].name], &task); err != nil || task.GetActionURL() == "" {
 		return func() tea.Msg {
-			return ClipboardMsg{Text: "JSON", Success: false, Error: err}
+			return ToastMsg{Message: "No action link for this task", IsError: true}
+		}
+	}
+
+	url := task.GetActionURL()
+	return func() tea.Msg {
+		if err := browser.OpenURL(url); err != nil {
+			return ToastMsg{Message: "✗ Failed to open browser", IsError: true}
 		}
+		return ToastMsg{Message: "Opening " + taskActionLabel(&task)}
 	}
-	return copyToClipboard(string(jsonBytes))
 }
 
-// copyToClipboard copies text to the system clipboard using platform-native tools
-func copyToClipboard(text string) tea.Cmd {
+// openMaps opens an address in the default maps application
+func openMaps(address string) tea.Cmd {
 	return func() tea.Msg {
 		var cmd *exec.Cmd
 		switch runtime.GOOS {
 		case "darwin":
-			cmd = exec.Command("pbcopy")
+			cmd = exec.Command("open", mapsURL(runtime.GOOS, address))
 		case "linux":
-			// Try xclip first, fall back to xsel
-			if _, err := exec.LookPath("xclip"); err == nil {
-				cmd = exec.Command("xclip", "-selection", "clipboard")
-			} else {
-				cmd = exec.Command("xsel", "--clipboard", "--input")
-			}
+			cmd = exec.Command("xdg-open", mapsURL(runtime.GOOS, address))
 		default:
-			return ClipboardMsg{Text: text, Success: false, Error: fmt.Errorf("unsupported platform")}
+			return ToastMsg{Message: "✗ Opening maps is not supported on this platform", IsError: true}
 		}
 
-		cmd.Stdin = strings.NewReader(text)
-		if err := cmd.Run(); err != nil {
-			return ClipboardMsg{Text: text, Success: false, Error: err}
+		if err := cmd.Start(); err != nil {
+			return ToastMsg{Message: "✗ Failed to open Maps", IsError: true}
 		}
-		return ClipboardMsg{Text: text, Success: true}
+		// Reap the launcher process without blocking the UI
+		go cmd.Wait()
+		return nil
 	}
 }
 
@@ -882,6 +3001,14 @@ func (m Model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Track hovering over the change indicators in the orders table
+	if msg.Action == tea.MouseActionMotion {
+		if m.view == ViewOrders {
+			m.hoveredOrder = m.changedOrderAt(msg.X, msg.Y)
+		}
+		return m, nil
+	}
+
 	// Only handle clicks in certain views
 	if m.view != ViewOrders && m.view != ViewDetail {
 		return m, nil
@@ -895,14 +3022,7 @@ func (m Model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	switch m.view {
 	case ViewOrders:
 		// Calculate which row was clicked (accounting for header, padding, and table borders)
-		// AppStyle padding: 1 line top
-		// Title: 1 line
-		// Blank line before table: 1 line
-		// Table top border: 1 line
-		// Table header row: 1 line
-		// Table header border: 1 line
-		headerLines := 6 // padding + title + blank + top border + header + header border
-		clickedRow := msg.Y - headerLines
+		clickedRow := msg.Y - ordersTableFirstRow
 
 		if clickedRow >= 0 && clickedRow < len(m.orders) {
 			if clickedRow == m.selectedOrder {
@@ -911,22 +3031,18 @@ func (m Model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 				m.selectedTab = TabDetails
 				m.viewport.SetContent(m.getTabContent())
 				m.viewport.GotoTop()
+				return m, m.startCountdown()
 			} else {
 				m.selectedOrder = clickedRow
 			}
 		}
 
 	case ViewDetail:
-		// Check if clicking on tabs area
-		// Tabs are at approximately line 4 (after header and subtitle)
-		tabLine := 4
-		if msg.Y == tabLine {
-			// Calculate which tab was clicked based on X position
-			// Each tab is roughly 10 characters wide with padding
-			tabWidth := 10
-			clickedTab := msg.X / tabWidth
-			if clickedTab >= 0 && clickedTab < 4 {
-				m.selectedTab = Tab(clickedTab)
+		// Tabs sit below the header line and a blank line
+		if msg.Y == detailTabLine {
+			if tab, ok := m.tabAt(msg.X); ok {
+				m.selectedTab = tab
+				m.onTabSwitch()
 				m.viewport.SetContent(m.getTabContent())
 				m.viewport.GotoTop()
 			}
@@ -936,7 +3052,136 @@ func (m Model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// Minimum terminal size
+// Screen positions in the detail view, accounting for AppStyle padding
+const (
+	appPaddingLeft = 2
+	detailTabLine  = 3 // top padding, header line and a blank line
+)
+
+// ordersTableFirstRow is the screen line of the first row in the orders
+// table: padding, title and its margin, blank line, top border, header and
+// header border
+const ordersTableFirstRow = 7
+
+// changedOrderAt returns the index of the order whose change indicator is at
+// screen position (x, y), or -1 when there is none
+func (m Model) changedOrderAt(x, y int) int {
+	if m.changedColumnX == nil {
+		return -1
+	}
+	bounds := *m.changedColumnX
+	if x < bounds[0] || x >= bounds[1] {
+		return -1
+	}
+	row := y - ordersTableFirstRow
+	if row < 0 || row >= len(m.orders) {
+		return -1
+	}
+	if _, ok := m.diffs[m.orders[row].Order.ReferenceNumber]; !ok {
+		return -1
+	}
+	return row
+}
+
+// maxTooltipFields is the number of changed fields named in the tooltip
+const maxTooltipFields = 3
+
+// changeTooltip renders the changed field names of the hovered order, or ""
+// when no order with changes is hovered
+func (m Model) changeTooltip() string {
+	if m.hoveredOrder < 0 || m.hoveredOrder >= len(m.orders) {
+		return ""
+	}
+	diffs := m.diffs[m.orders[m.hoveredOrder].Order.ReferenceNumber]
+	if len(diffs) == 0 {
+		return ""
+	}
+	return ChangeTooltipStyle.Render(changedFieldsSummary(diffs))
+}
+
+// changedFieldsSummary lists the first changed field names, e.g.
+// "VIN, Delivery Window, Vehicle Location (+2 more)"
+func changedFieldsSummary(diffs []model.OrderDiff) string {
+	var fields []string
+	for i, diff := range diffs {
+		if i == maxTooltipFields {
+			break
+		}
+		fields = append(fields, diff.Field)
+	}
+	summary := strings.Join(fields, ", ")
+	if extra := len(diffs) - maxTooltipFields; extra > 0 {
+		summary += fmt.Sprintf(" (+%d more)", extra)
+	}
+	return summary
+}
+
+// columnBounds returns the X range [start, end) of a column in a rendered
+// table, including its left and right borders, found from the separators in
+// the header line
+func columnBounds(table string, col int) (int, int) {
+	lines := strings.Split(table, "\n")
+	if len(lines) < 2 {
+		return 0, 0
+	}
+	var separators []int
+	x := 0
+	for _, r := range ansi.Strip(lines[1]) {
+		if r == '│' {
+			separators = append(separators, x)
+		}
+		x += ansi.StringWidth(string(r))
+	}
+	if col+1 >= len(separators) {
+		return 0, 0
+	}
+	return separators[col], separators[col+1] + 1
+}
+
+// overlay draws box over base with its top-left corner at (x, y), extending
+// base with blank lines when the box reaches past its end
+func overlay(base, box string, x, y int) string {
+	lines := strings.Split(base, "\n")
+	boxLines := strings.Split(box, "\n")
+	for len(lines) < y+len(boxLines) {
+		lines = append(lines, "")
+	}
+
+	// Keep the box within the width of base
+	width := lipgloss.Width(base)
+	boxWidth := lipgloss.Width(box)
+	if x+boxWidth > width {
+		x = max(0, width-boxWidth)
+	}
+
+	for i, boxLine := range boxLines {
+		line := lines[y+i]
+		left := ansi.Truncate(line, x, "")
+		if pad := x - ansi.StringWidth(left); pad > 0 {
+			left += strings.Repeat(" ", pad)
+		}
+		right := ansi.TruncateLeft(line, x+ansi.StringWidth(boxLine), "")
+		lines[y+i] = left + boxLine + right
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tabAt returns the detail tab rendered at screen column x, using the
+// boundaries recorded by the last renderTabs
+func (m Model) tabAt(x int) (Tab, bool) {
+	if m.tabXBoundaries == nil || len(*m.tabXBoundaries) < 2 {
+		return 0, false
+	}
+	boundaries := *m.tabXBoundaries
+	// First boundary to the right of x; the tab ends there
+	i := sort.Search(len(boundaries), func(i int) bool { return boundaries[i] > x })
+	if i == 0 || i == len(boundaries) {
+		return 0, false
+	}
+	return Tab(i - 1), true
+}
+
+// Default minimum terminal size
 const (
 	minTerminalWidth  = 80
 	minTerminalHeight = 24
@@ -945,7 +3190,7 @@ const (
 // View renders the UI
 func (m Model) View() string {
 	// Check minimum terminal size
-	if m.width > 0 && m.height > 0 && (m.width < minTerminalWidth || m.height < minTerminalHeight) {
+	if m.width > 0 && m.height > 0 && (m.width < m.minWidth || m.height < m.minHeight) {
 		return m.viewTerminalTooSmall()
 	}
 
@@ -958,6 +3203,12 @@ func (m Model) View() string {
 		return m.viewDetail()
 	case ViewHelp:
 		return m.viewHelp()
+	case ViewSettings:
+		return m.viewSettings()
+	case ViewStats:
+		return m.viewStats()
+	case ViewChangeLog:
+		return m.viewChangeLog()
 	default:
 		return "Unknown view"
 	}
@@ -969,7 +3220,7 @@ func (m Model) viewTerminalTooSmall() string {
 		"",
 		ErrorStyle.Render("Terminal too small"),
 		"",
-		HelpStyle.Render(fmt.Sprintf("Minimum: %d×%d", minTerminalWidth, minTerminalHeight)),
+		HelpStyle.Render(fmt.Sprintf("Minimum: %d×%d", m.minWidth, m.minHeight)),
 		HelpStyle.Render(fmt.Sprintf("Current: %d×%d", m.width, m.height)),
 		"",
 		HelpStyle.Render("Please resize your terminal window."),
@@ -1025,9 +3276,14 @@ func (m Model) layoutWithFooter(content, footer string) string {
 	)
 }
 
+// formatInterval formats a refresh interval in whole minutes (e.g. "5 min")
+func formatInterval(d time.Duration) string {
+	return fmt.Sprintf("%d min", int(d.Minutes()))
+}
+
 // relativeTime returns a human-readable relative time string
 func relativeTime(t time.Time) string {
-	now := time.Now()
+	now := timeNow()
 	diff := now.Sub(t)
 
 	switch {
@@ -1097,7 +3353,11 @@ func (m Model) viewLogin() string {
 	}
 
 	// Wrap in login card and center horizontally
-	card := LoginCardStyle.Render(cardContent)
+	loginCardStyle := LoginCardStyle
+	if m.minimalMode {
+		loginCardStyle = lipgloss.NewStyle().Width(70)
+	}
+	card := loginCardStyle.Render(cardContent)
 	cardWidth := lipgloss.Width(card)
 	leftMargin := 0
 	if m.width > cardWidth+4 {
@@ -1117,13 +3377,18 @@ func (m Model) viewOrders() string {
 	if m.confirmingLogout {
 		help = HelpStyle.Render("Logout? Press 'y' to confirm, 'n' or 'esc' to cancel")
 	} else {
-		help = HelpStyle.Render(OrdersKeys())
+		help = HelpStyle.Render(OrdersKeys(m.sortMode))
 	}
 
 	var content string
 
 	if m.confirmingLogout {
 		content = m.renderLogoutConfirmation()
+	} else if m.loading && m.loadingProgress.Total > 0 {
+		content = fmt.Sprintf("\n%s Loading details %d/%d…", m.spinner.View(), m.loadingProgress.Current, m.loadingProgress.Total)
+		if !m.minimalMode {
+			content = "\n" + m.progressBar.View() + "\n" + content
+		}
 	} else if m.loading {
 		content = fmt.Sprintf("\n%s Loading orders...", m.spinner.View())
 	} else if m.err != nil {
@@ -1131,6 +3396,15 @@ func (m Model) viewOrders() string {
 	} else if len(m.orders) == 0 {
 		content = m.renderEmptyState()
 	} else {
+		// The summary takes the line below the title, so table rows stay at
+		// ordersTableFirstRow
+		if summary := renderQuickSummary(m.orders, m.diffs); summary != "" {
+			title = lipgloss.JoinVertical(lipgloss.Left,
+				TitleStyle.MarginBottom(0).Render("⚡ Tesla Delivery Status"),
+				SubtitleStyle.MarginBottom(0).Render(ansi.Truncate(summary, max(m.width-4, 1), "…")),
+			)
+		}
+
 		// Build orders table with lipgloss/table
 		tableWidth := m.width - 4
 		if tableWidth < 80 {
@@ -1140,41 +3414,45 @@ func (m Model) viewOrders() string {
 		selectedOrder := m.selectedOrder
 		orderDiffs := m.diffs
 
-		var tableRows [][]string
-		for i, order := range m.orders {
-			vin := order.Order.GetVIN()
-			if len(vin) > 17 {
-				vin = vin[:17]
-			}
+		columns := m.columns
+		if len(columns) == 0 {
+			columns, _ = resolveColumns(nil)
+		}
 
-			deliveryWindow := order.GetDeliveryWindow()
-			if len(deliveryWindow) > 25 {
-				deliveryWindow = deliveryWindow[:22] + "..."
+		headers := make([]string, len(columns))
+		changedCol := -1
+		for i, col := range columns {
+			headers[i] = col.name
+			if col.name == "Changed" {
+				changedCol = i
 			}
+		}
 
-			changeIndicator := " "
-			if _, hasChanges := orderDiffs[order.Order.ReferenceNumber]; hasChanges {
-				changeIndicator = "✓"
-			}
+		var tableRows [][]string
+		for i, order := range m.orders {
+			_, hasChanges := orderDiffs[order.Order.ReferenceNumber]
 
-			modelName := order.Order.GetModelName()
+			row := make([]string, len(columns))
+			for j, col := range columns {
+				row[j] = col.value(order, hasChanges)
+				if col.name == "Model" && m.watched[order.Order.ReferenceNumber] {
+					row[j] = "👁 " + row[j]
+				}
+				if col.name == "Model" && m.pinned[order.Order.ReferenceNumber] {
+					row[j] = "📌 " + row[j]
+				}
+			}
 			if i == selectedOrder {
-				modelName = "▸ " + modelName
+				row[0] = "▸ " + row[0]
 			}
 
-			tableRows = append(tableRows, []string{
-				modelName,
-				order.Order.OrderStatus,
-				vin,
-				deliveryWindow,
-				changeIndicator,
-			})
+			tableRows = append(tableRows, row)
 		}
 
 		t := table.New().
-			Headers("Model", "Status", "VIN", "Delivery Window", "Changed").
+			Headers(headers...).
 			Rows(tableRows...).
-			Border(lipgloss.RoundedBorder()).
+			Border(m.tableBorder()).
 			BorderStyle(lipgloss.NewStyle().Foreground(TeslaGray)).
 			Width(tableWidth).
 			StyleFunc(func(row, col int) lipgloss.Style {
@@ -1190,7 +3468,7 @@ func (m Model) viewOrders() string {
 				}
 
 				// Change indicator column
-				if col == 4 {
+				if col == changedCol {
 					return s.Foreground(StatusGreen)
 				}
 
@@ -1202,7 +3480,25 @@ func (m Model) viewOrders() string {
 				return s
 			})
 
-		content = "\n" + t.Render()
+		rendered := t.Render()
+		if changedCol >= 0 {
+			start, end := columnBounds(rendered, changedCol)
+			if m.changedColumnX != nil {
+				*m.changedColumnX = [2]int{appPaddingLeft + start, appPaddingLeft + end}
+			}
+			if tooltip := m.changeTooltip(); tooltip != "" {
+				// Rows start below the top border, header and header border
+				rendered = overlay(rendered, tooltip, start, m.hoveredOrder+4)
+			}
+		}
+
+		content = "\n" + rendered
+		if m.animationFrame > 0 {
+			content = "\n" + renderConfetti(tableWidth, m.animationFrame) + content
+		}
+		if m.offline {
+			content = "\n" + WarningStyle.Render("⚠ Offline – showing cached data") + content
+		}
 	}
 
 	// Calculate content and create layout with footer at bottom
@@ -1222,8 +3518,12 @@ func (m Model) viewDetail() string {
 	titleLeft := TitleStyle.MarginBottom(0).Render("⚡ Tesla Delivery Status")
 	statusStyle := GetStatusBadgeStyle(order.Order.OrderStatus)
 	refStyle := lipgloss.NewStyle().Foreground(Muted)
+	modelName := SubheadingStyle.Render(order.Order.GetModelName())
+	if order.GetIsUsed() {
+		modelName += " " + PreOwnedBadgeStyle.Render("Pre-Owned")
+	}
 	orderInfo := lipgloss.JoinHorizontal(lipgloss.Center,
-		SubheadingStyle.Render(order.Order.GetModelName()),
+		modelName,
 		"  ",
 		statusStyle.Render(order.Order.OrderStatus),
 		"  ",
@@ -1243,14 +3543,32 @@ func (m Model) viewDetail() string {
 	if m.viewport.TotalLineCount() > m.viewport.Height {
 		scrollPercent = fmt.Sprintf(" (%d%%)", int(m.viewport.ScrollPercent()*100))
 	}
+	if overflow := m.jsonOverflow(); overflow > 0 {
+		scrollPercent += fmt.Sprintf(" ↔ %d%%", m.jsonScrollX*100/overflow)
+	}
 
 	help := HelpStyle.Render(DetailKeys(m.selectedTab) + scrollPercent)
 
+	body := m.viewport.View()
+	if m.selectedTab == TabJSON && (m.jsonSearching || m.jsonSearchQuery != "") {
+		// The search bar takes the viewport's first line
+		vp := m.viewport
+		vp.Height--
+		body = lipgloss.JoinVertical(lipgloss.Left, m.renderJSONSearchBar(), vp.View())
+	}
+	if m.resetConfirming {
+		help = HelpStyle.Render("Reset checklist? Press 'y' to confirm, 'n' or 'esc' to cancel")
+		body = m.renderResetConfirmation()
+	}
+	if m.animationFrame > 0 {
+		body = renderConfetti(m.viewport.Width, m.animationFrame)
+	}
+
 	topContent := lipgloss.JoinVertical(lipgloss.Left,
 		headerLine,
 		"",
 		tabs,
-		m.viewport.View(),
+		body,
 	)
 
 	return m.layoutWithFooter(topContent, help)
@@ -1268,27 +3586,286 @@ func (m Model) viewHelp() string {
 	lines = append(lines, "")
 	lines = append(lines, helpContent)
 
-	// Show auto-refresh status if enabled
+	// Actions left out of the footers
+	lines = append(lines, "")
+	lines = append(lines, SubheadingStyle.Render("Orders"))
+	lines = append(lines, m.help.FullHelpView(OrdersHelp))
+	lines = append(lines, "")
+	lines = append(lines, SubheadingStyle.Render("Order Details"))
+	lines = append(lines, m.help.FullHelpView(DetailHelp))
+
+	// Auto-refresh status and interval adjustment
+	lines = append(lines, "")
+	lines = append(lines, SubheadingStyle.Render("Auto-Refresh"))
 	if m.autoRefresh {
-		lines = append(lines, "")
-		lines = append(lines, SubheadingStyle.Render("Auto-Refresh"))
-		lines = append(lines, SuccessStyle.Render(fmt.Sprintf("  ● Enabled (every %s)", m.autoRefreshInterval)))
+		lines = append(lines, SuccessStyle.Render(fmt.Sprintf("  ● Enabled (every %s)", formatInterval(m.autoRefreshInterval))))
 		if !m.lastRefresh.IsZero() {
 			lines = append(lines, HelpStyle.Render(fmt.Sprintf("  Last refresh: %s", relativeTime(m.lastRefresh))))
 		}
+	} else {
+		lines = append(lines, HelpStyle.Render("  ○ Disabled (start with --watch to enable)"))
 	}
+	mutedStyle := lipgloss.NewStyle().Foreground(Muted)
+	lines = append(lines, fmt.Sprintf("  %s %s  %s",
+		mutedStyle.Render("Interval:"),
+		ChangedValueStyle.Render(formatInterval(m.autoRefreshInterval)),
+		mutedStyle.Render("(+/-: adjust)"),
+	))
+	lines = append(lines, HelpStyle.Render("  r waits for the API rate limit; ctrl+r refreshes immediately"))
 
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
 
 	// Wrap in a card/box
-	boxContent := CardStyle.Render(content)
+	boxContent := m.cardStyle().Render(content)
 
-	helpFooter := HelpStyle.Render("Press Esc, ?, or Enter to close")
+	helpFooter := HelpStyle.Render("+/-: refresh interval • Press Esc, ?, or Enter to close")
 
 	topContent := lipgloss.JoinVertical(lipgloss.Left, title, sectionTitle, "", boxContent)
 	return m.layoutWithFooter(topContent, helpFooter)
 }
 
+// viewSettings renders the settings screen
+func (m Model) viewSettings() string {
+	title := TitleStyle.Render("⚡ Tesla Delivery Status")
+	sectionTitle := SubheadingStyle.Render("Settings")
+
+	boxContent := m.cardStyle().Render(m.renderSettings())
+	footer := HelpStyle.Render("↑/↓: navigate • enter: change • s/esc: save and close")
+
+	topContent := lipgloss.JoinVertical(lipgloss.Left, title, sectionTitle, "", boxContent)
+	return m.layoutWithFooter(topContent, footer)
+}
+
+// renderSettings renders the list of configurable settings with their current values
+func (m Model) renderSettings() string {
+	var lines []string
+	for i, item := range settingItems {
+		cursor := "  "
+		valueStyle := ValueStyle
+		if i == m.settingsCursor {
+			cursor = ChangedValueStyle.Render("> ")
+			valueStyle = ChangedValueStyle
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s",
+			cursor,
+			LabelStyle.Render(item.label+":"),
+			valueStyle.Render(item.value(m.settingsDraft)),
+		))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// viewStats renders aggregate statistics across all orders
+func (m Model) viewStats() string {
+	title := TitleStyle.Render("⚡ Tesla Delivery Status")
+	sectionTitle := SubheadingStyle.Render("Order Statistics")
+
+	footer := HelpStyle.Render("Press Esc, i, or Enter to close")
+
+	topContent := lipgloss.JoinVertical(lipgloss.Left, title, sectionTitle, "", m.renderStats())
+	return m.layoutWithFooter(topContent, footer)
+}
+
+// changeLogEntry is a single field change of an order in the change log
+type changeLogEntry struct {
+	timestamp time.Time
+	modelName string
+	diff      model.OrderDiff
+}
+
+// loadChangeLog returns the changes between consecutive snapshots of every
+// stored order history (or demo data), newest first
+func (m Model) loadChangeLog() ([]changeLogEntry, error) {
+	var histories []*model.OrderHistory
+	if m.demoMode && m.demoHistory != nil {
+		for _, history := range m.demoHistory {
+			histories = append(histories, history)
+		}
+	} else {
+		var err error
+		histories, err = m.history.ListAllHistories()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []changeLogEntry
+	for _, history := range histories {
+		for i := 1; i < len(history.Snapshots); i++ {
+			snapshot := history.Snapshots[i]
+			modelName := snapshot.Data.Order.GetModelName()
+			for _, diff := range model.CompareOrders(history.Snapshots[i-1].Data, snapshot.Data) {
+				entries = append(entries, changeLogEntry{timestamp: snapshot.Timestamp, modelName: modelName, diff: diff})
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].timestamp.After(entries[j].timestamp)
+	})
+	return entries, nil
+}
+
+// describeChange summarizes a field change for the change log, e.g.
+// "VIN assigned" or "Order Status: BOOKED → IN_TRANSIT"
+func describeChange(diff model.OrderDiff) string {
+	oldVal := fmt.Sprintf("%v", diff.OldValue)
+	newVal := fmt.Sprintf("%v", diff.NewValue)
+	switch {
+	case isEmptyValue(oldVal):
+		return diff.Field + " assigned"
+	case isEmptyValue(newVal):
+		return diff.Field + " removed"
+	default:
+		return fmt.Sprintf("%s: %s → %s", diff.Field, oldVal, newVal)
+	}
+}
+
+// isEmptyValue reports whether a diff value means "not set"
+func isEmptyValue(v string) bool {
+	return v == "" || v == "N/A" || v == "<nil>"
+}
+
+// viewChangeLog renders the changes across all orders as one timeline
+func (m Model) viewChangeLog() string {
+	title := TitleStyle.Render("⚡ Tesla Delivery Status")
+	sectionTitle := SubheadingStyle.Render("Change Log")
+
+	footer := HelpStyle.Render("↑/↓: scroll • Press Esc, C, or Enter to close")
+
+	topContent := lipgloss.JoinVertical(lipgloss.Left, title, sectionTitle, "", m.renderChangeLog())
+	return m.layoutWithFooter(topContent, footer)
+}
+
+// renderChangeLog renders the visible part of the change log, one change
+// per line
+func (m Model) renderChangeLog() string {
+	if len(m.changeLog) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			HelpStyle.Render("No changes recorded yet."),
+			HelpStyle.Render("Changes appear here once an order has been refreshed after an update."),
+		)
+	}
+
+	// Title, subheading, spacing, toast, footer and padding take ~10 lines
+	visible := m.height - 10
+	if visible < 1 {
+		visible = 1
+	}
+	end := m.changeLogScroll + visible
+	if end > len(m.changeLog) {
+		end = len(m.changeLog)
+	}
+
+	var lines []string
+	for _, entry := range m.changeLog[m.changeLogScroll:end] {
+		lines = append(lines, fmt.Sprintf("%s – %s: %s",
+			HelpStyle.Render(relativeTime(entry.timestamp)),
+			SubheadingStyle.Render(entry.modelName),
+			ValueStyle.Render(describeChange(entry.diff)),
+		))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderStats renders the aggregate order statistics as a table
+func (m Model) renderStats() string {
+	stats := model.ComputeStats(m.orders)
+
+	earliest := "N/A"
+	if stats.EarliestDelivery != nil {
+		earliest = format.FormatDate(*stats.EarliestDelivery, m.dateFormat) + " " + stats.EarliestDelivery.Format("3:04 PM")
+	}
+
+	rows := [][]string{
+		{"Total orders", fmt.Sprintf("%d", stats.TotalOrders)},
+		{"Earliest delivery", earliest},
+		{"Total amount due", formatThousands(stats.TotalAmountDue)},
+	}
+
+	statuses := make([]string, 0, len(stats.ByStatus))
+	for status := range stats.ByStatus {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		rows = append(rows, []string{"Status: " + status, fmt.Sprintf("%d", stats.ByStatus[status])})
+	}
+
+	t := table.New().
+		Headers("Metric", "Value").
+		Rows(rows...).
+		Border(m.tableBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(TeslaGray)).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			s := lipgloss.NewStyle().Padding(0, 1)
+			if row == table.HeaderRow {
+				return s.Bold(true).Foreground(TeslaWhite).Background(TeslaRed)
+			}
+			if col == 0 {
+				return s.Foreground(Muted)
+			}
+			return s.Foreground(TeslaWhite)
+		})
+
+	return t.Render()
+}
+
+// renderQuickSummary summarizes the first order that isn't delivered yet
+// in one line, such as "1 active order • VIN assigned • Payment due •
+// Delivery: June 15", followed by the number of unacknowledged changes
+func renderQuickSummary(orders []model.CombinedOrder, diffs map[string][]model.OrderDiff) string {
+	if len(orders) == 0 {
+		return ""
+	}
+
+	var parts []string
+	var active []model.CombinedOrder
+	for _, order := range orders {
+		if !isDeliveredStatus(order.Order.OrderStatus) {
+			active = append(active, order)
+		}
+	}
+	if len(active) == 0 {
+		parts = append(parts, "All orders delivered")
+	} else {
+		if len(active) == 1 {
+			parts = append(parts, "1 active order")
+		} else {
+			parts = append(parts, fmt.Sprintf("%d active orders", len(active)))
+		}
+
+		order := active[0]
+		if vin := order.Order.GetVIN(); vin != "" && vin != "N/A" {
+			parts = append(parts, "VIN assigned")
+		} else {
+			parts = append(parts, "Awaiting VIN")
+		}
+		if _, _, complete := order.GetPaymentStatus(); complete {
+			parts = append(parts, "Paid")
+		} else if _, ok := order.Details.Tasks.Raw["finalPayment"]; ok || order.Details.Tasks.FinalPayment != nil {
+			parts = append(parts, "Payment due")
+		}
+		if appt, ok := model.ParseAppointmentTime(order.GetParsedAppointment()); ok {
+			parts = append(parts, "Delivery: "+appt.Format("January 2"))
+		} else if window := order.GetDeliveryWindow(); window != "N/A" {
+			parts = append(parts, "Delivery: "+window)
+		}
+	}
+
+	changes := 0
+	for _, orderDiffs := range diffs {
+		changes += len(orderDiffs)
+	}
+	if changes == 1 {
+		parts = append(parts, "1 change")
+	} else if changes > 1 {
+		parts = append(parts, fmt.Sprintf("%d changes", changes))
+	}
+
+	return strings.Join(parts, " • ")
+}
+
 // renderEmptyState renders a friendly empty state message
 func (m Model) renderEmptyState() string {
 	var lines []string
@@ -1323,12 +3900,27 @@ func (m Model) renderLogoutConfirmation() string {
 		"",
 	)
 
-	box := CardStyle.Width(50).Render(confirmContent)
+	box := m.cardStyle().Width(50).Render(confirmContent)
 	lines = append(lines, box)
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderResetConfirmation renders the checklist reset confirmation dialog
+func (m Model) renderResetConfirmation() string {
+	confirmContent := lipgloss.JoinVertical(lipgloss.Center,
+		"",
+		SubheadingStyle.Render("Reset all checklist items? y/n"),
+		"",
+		HelpStyle.Render("This will uncheck every item for this order."),
+		"",
+		ValueStyle.Render("[Y]es    [N]o"),
+		"",
+	)
+
+	return lipgloss.JoinVertical(lipgloss.Left, "", m.cardStyle().Width(50).Render(confirmContent))
+}
+
 // getTabContent returns the content for the current tab
 func (m Model) getTabContent() string {
 	if m.selectedOrder >= len(m.orders) {
@@ -1342,6 +3934,9 @@ func (m Model) getTabContent() string {
 	case TabDetails:
 		return m.renderDetailsTab(order, diffs)
 	case TabTasks:
+		if m.taskDetailOverlay {
+			return m.renderTaskDetail(order, m.focusedTask)
+		}
 		return m.renderTasksTab(order)
 	case TabChecklist:
 		return m.renderChecklistTab(order)
@@ -1362,7 +3957,7 @@ func (m Model) renderTabs() string {
 		ref := m.orders[m.selectedOrder].Order.ReferenceNumber
 		state, err := m.checklist.LoadState(ref)
 		if err == nil {
-			completed, total := storage.CountCompleted(state.Checked)
+			completed, total := storage.CountCompletedIn(m.selectedChecklist(), state.Checked)
 			tabNames[2] = fmt.Sprintf("Checklist %d/%d", completed, total)
 		}
 	}
@@ -1386,19 +3981,73 @@ func (m Model) renderTabs() string {
 		}
 	}
 
+	if m.rawViewMode {
+		tabNames[4] = "JSON (raw)"
+	}
+
 	var tabs []string
+	x := appPaddingLeft
+	boundaries := []int{x}
 	for i, name := range tabNames {
 		style := TabStyle
 		if Tab(i) == m.selectedTab {
 			style = ActiveTabStyle
 		}
-		tabs = append(tabs, style.Render(name))
+		tab := style.Render(name)
+		if m.minimalMode {
+			// Plain text tabs, with brackets marking the active one
+			tab = " " + name + " "
+			if Tab(i) == m.selectedTab {
+				tab = "[" + name + "]"
+			}
+		}
+		tabs = append(tabs, tab)
+		x += lipgloss.Width(tab)
+		boundaries = append(boundaries, x)
+	}
+	if m.tabXBoundaries != nil {
+		*m.tabXBoundaries = boundaries
 	}
 
 	tabBar := lipgloss.JoinHorizontal(lipgloss.Top, tabs...)
+	if m.minimalMode {
+		// A blank line takes the place of the border, keeping the layout
+		return tabBar + "\n"
+	}
 	return TabBarStyle.Width(m.width - 4).Render(tabBar)
 }
 
+// cardStyle returns the style for boxed screens such as help and settings
+func (m Model) cardStyle() lipgloss.Style {
+	if m.minimalMode {
+		return lipgloss.NewStyle()
+	}
+	return CardStyle
+}
+
+// sectionBoxStyle returns the style for sections in the detail tabs. In
+// minimal mode the border is hidden but keeps its space, so the content
+// width stays the same.
+func (m Model) sectionBoxStyle() lipgloss.Style {
+	if m.minimalMode {
+		return lipgloss.NewStyle().Border(lipgloss.HiddenBorder()).Padding(0, 1)
+	}
+	return SectionBoxStyle
+}
+
+// tableBorder returns the border of the orders and stats tables; hidden in
+// minimal mode, keeping table rows where mouse clicks expect them, and
+// plain ASCII with --no-color
+func (m Model) tableBorder() lipgloss.Border {
+	if m.minimalMode {
+		return lipgloss.HiddenBorder()
+	}
+	if m.noColor {
+		return lipgloss.ASCIIBorder()
+	}
+	return lipgloss.RoundedBorder()
+}
+
 // renderLabelValue renders a label-value pair with consistent alignment using LabelStyle and ValueStyle
 func renderLabelValue(label, value string) string {
 	return fmt.Sprintf("  %s %s",
@@ -1464,12 +4113,26 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 
 	var lines []string
 
+	// Vehicle art with the order badges beside it
+	if header := m.renderVehicleHeader(order); header != "" {
+		lines = append(lines, header)
+		lines = append(lines, "")
+	}
+
 	// Show change summary banner if there are recent changes
 	if len(diffs) > 0 {
 		changesBanner := DiffAddedStyle.Render(fmt.Sprintf("● %d change(s) detected since last check:", len(diffs)))
 		lines = append(lines, changesBanner)
-		for _, diff := range diffs {
-			lines = append(lines, HelpStyle.Render(fmt.Sprintf("  • %s: %v → %v", diff.Field, diff.OldValue, diff.NewValue)))
+		for i, diff := range diffs {
+			change := fmt.Sprintf("%s: %v → %v", diff.Field, diff.OldValue, diff.NewValue)
+			if m.detailFocus && i == m.detailCursor {
+				lines = append(lines, ChangedValueStyle.Render("> ")+ChangedValueStyle.Render(change))
+				continue
+			}
+			lines = append(lines, HelpStyle.Render("  • "+change))
+		}
+		if m.detailFocus {
+			lines = append(lines, HelpStyle.Render("  ↑/↓: select • a: mark as seen • esc: done"))
 		}
 		lines = append(lines, "")
 	}
@@ -1492,7 +4155,11 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 	}
 
 	// Order Timeline
-	lines = append(lines, m.renderOrderTimeline(order))
+	timeline, ok := m.timelines[order.Order.ReferenceNumber]
+	if !ok {
+		timeline = newOrderTimeline(order, nil)
+	}
+	lines = append(lines, m.renderOrderTimeline(order, timeline.timeline, timeline.timestamps))
 	lines = append(lines, "")
 
 	// Delivery Countdown
@@ -1509,7 +4176,7 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 
 	// Parsed appointment details
 	if appt := order.GetParsedAppointment(); appt != nil {
-		detailFields = append(detailFields, renderField("Appointment Date", appt.Date))
+		detailFields = append(detailFields, renderField("Appointment Date", m.formatAppointmentDate(appt)))
 		if appt.Time != "" {
 			detailFields = append(detailFields, renderField("Appointment Time", appt.Time))
 		}
@@ -1524,7 +4191,7 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 	detailFields = append(detailFields, renderField("Vehicle Location", order.GetVehicleLocation()))
 	detailFields = append(detailFields, renderField("Delivery Method", order.GetDeliveryType()))
 	detailFields = append(detailFields, renderField("Delivery Center", data.GetStoreName(order.GetDeliveryCenter())))
-	detailFields = append(detailFields, renderField("Odometer", order.GetOdometer()))
+	detailFields = append(detailFields, renderField("Odometer", order.GetFormattedOdometer(m.units)))
 
 	// Reservation and order dates
 	if order.GetReservationDate() != "N/A" {
@@ -1539,7 +4206,21 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 	}
 
 	lines = append(lines, SubheadingStyle.Render("Order Details"))
-	lines = append(lines, SectionBoxStyle.Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, detailFields...)))
+	lines = append(lines, m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, detailFields...)))
+
+	if url := order.GetSelfSchedulingURL(); url != "" {
+		lines = append(lines, fmt.Sprintf("  %s %s %s",
+			HighlightStyle.Render("Schedule now →"),
+			ValueStyle.Render(url),
+			HelpStyle.Render("(O: open)"),
+		))
+	}
+
+	// Pre-Owned Vehicle Section
+	if preOwnedSection := m.renderPreOwnedDetails(order); preOwnedSection != "" {
+		lines = append(lines, "")
+		lines = append(lines, preOwnedSection)
+	}
 
 	// Payment Summary Section
 	if paymentSection := m.renderPaymentSummary(order); paymentSection != "" {
@@ -1580,7 +4261,7 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 
 		lines = append(lines, "")
 		lines = append(lines, SubheadingStyle.Render("Vehicle Options"))
-		lines = append(lines, SectionBoxStyle.Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, optLines...)))
+		lines = append(lines, m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, optLines...)))
 	}
 
 	// Trade-In Section
@@ -1593,8 +4274,10 @@ func (m Model) renderDetailsTab(order model.CombinedOrder, diffs []model.OrderDi
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
-// renderOrderTimeline renders the order progress timeline
-func (m Model) renderOrderTimeline(order model.CombinedOrder) string {
+// renderOrderTimeline renders the order progress timeline, annotating
+// completed stages with the date they were reached and how long after the
+// order that was
+func (m Model) renderOrderTimeline(order model.CombinedOrder, timeline model.OrderTimeline, timestamps map[string]time.Time) string {
 	var timelineLines []string
 
 	// Determine which stages are complete (must be sequential)
@@ -1604,14 +4287,24 @@ func (m Model) renderOrderTimeline(order model.CombinedOrder) string {
 	isDelivered := strings.Contains(strings.ToLower(order.Order.OrderStatus), "deliver")
 
 	// Sequential completion - each stage requires previous stages
+	configureComplete := order.Order.IsConfigured()
 	vinComplete := hasVIN
 	transitComplete := hasVIN && hasTransitInfo
 	readyComplete := hasVIN && hasAppointment
 	deliveredComplete := isDelivered
 
 	// Timeline stages with sequential logic
-	stageNames := []string{"Order Placed", "VIN Assigned", "In Transit", "Ready for Delivery", "Delivered"}
-	stageComplete := []bool{true, vinComplete, transitComplete, readyComplete, deliveredComplete}
+	stageNames := []string{"Order Placed", model.StageConfigure, model.StageVINAssigned, model.StageInTransit, model.StageReadyForDelivery, model.StageDelivered}
+	stageComplete := []bool{true, configureComplete, vinComplete, transitComplete, readyComplete, deliveredComplete}
+
+	// The tasks "state" entry, when present, is more reliable than the
+	// heuristics above for the stages it covers
+	state := order.GetTaskState()
+	for i, name := range stageNames {
+		if complete, ok := state.StageComplete(name); ok {
+			stageComplete[i] = complete
+		}
+	}
 
 	// Find current stage (first incomplete, or last if all complete)
 	currentStage := len(stageNames) - 1
@@ -1641,7 +4334,20 @@ func (m Model) renderOrderTimeline(order model.CombinedOrder) string {
 			nameStyle = mutedStyle
 		}
 
-		timelineLines = append(timelineLines, icon+" "+nameStyle.Render(name))
+		line := icon + " " + nameStyle.Render(name)
+		if stageComplete[i] {
+			var notes []string
+			if t, ok := timestamps[name]; ok {
+				notes = append(notes, t.Format("Jan 2"))
+			}
+			if d, ok := timeline.StageTimings[name]; ok {
+				notes = append(notes, formatStageTiming(d))
+			}
+			if len(notes) > 0 {
+				line += mutedStyle.Render(" (" + strings.Join(notes, ", ") + ")")
+			}
+		}
+		timelineLines = append(timelineLines, line)
 
 		// Connector line (except for last stage)
 		if i < len(stageNames)-1 {
@@ -1655,7 +4361,7 @@ func (m Model) renderOrderTimeline(order model.CombinedOrder) string {
 
 	// Wrap timeline content in a box
 	timelineContent := strings.Join(timelineLines, "\n")
-	boxedTimeline := SectionBoxStyle.Width(m.sectionWidth()).Render(timelineContent)
+	boxedTimeline := m.sectionBoxStyle().Width(m.sectionWidth()).Render(timelineContent)
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		SubheadingStyle.Render("Order Timeline"),
@@ -1663,6 +4369,19 @@ func (m Model) renderOrderTimeline(order model.CombinedOrder) string {
 	)
 }
 
+// formatStageTiming describes how long after the order a stage was reached
+func formatStageTiming(d time.Duration) string {
+	days := int(d.Hours() / 24)
+	switch days {
+	case 0:
+		return "same day as order"
+	case 1:
+		return "1 day after order"
+	default:
+		return fmt.Sprintf("%d days after order", days)
+	}
+}
+
 // renderDeliveryGates renders the delivery readiness checklist
 func (m Model) renderDeliveryGates(order model.CombinedOrder) string {
 	var lines []string
@@ -1678,52 +4397,29 @@ func (m Model) renderDeliveryGates(order model.CombinedOrder) string {
 
 	var gates []gate
 
-	// Parse tasks to determine gate status
-	if order.Details.Tasks.Raw != nil {
-		// Customer tasks
-		if raw, ok := order.Details.Tasks.Raw["registration"]; ok {
-			var task struct {
-				Complete bool `json:"complete"`
-				Required bool `json:"required"`
-			}
-			json.Unmarshal(raw, &task)
-			gates = append(gates, gate{"Complete Registration", task.Complete, "Customer", task.Required && !task.Complete})
-		}
-
-		if raw, ok := order.Details.Tasks.Raw["scheduling"]; ok {
-			var task struct {
-				Complete bool `json:"complete"`
-				Required bool `json:"required"`
-			}
-			json.Unmarshal(raw, &task)
-			gates = append(gates, gate{"Schedule Delivery", task.Complete, "Customer", task.Required && !task.Complete})
-		}
-
-		if raw, ok := order.Details.Tasks.Raw["finalPayment"]; ok {
-			var task struct {
-				Complete bool `json:"complete"`
-				Required bool `json:"required"`
+	// Customer gates, from the task statuses
+	statuses := order.GetAllTaskStatuses()
+	blocking := make(map[string]bool)
+	for _, name := range order.GetBlockingTasks() {
+		blocking[name] = true
+	}
+	customerGates := []struct{ task, name string }{
+		{"registration", "Complete Registration"},
+		{"scheduling", "Schedule Delivery"},
+		{"finalPayment", "Final Payment"},
+		{"insurance", "Insurance"},
+		{"tradeIn", "Trade-In"},
+	}
+	for _, cg := range customerGates {
+		if cg.task == "insurance" {
+			if _, ok := statuses[cg.task]; ok {
+				_, complete := order.GetInsuranceStatus()
+				gates = append(gates, gate{cg.name, complete, "Customer", blocking[cg.task] && !complete})
 			}
-			json.Unmarshal(raw, &task)
-			gates = append(gates, gate{"Final Payment", task.Complete, "Customer", task.Required && !task.Complete})
-		}
-
-		if raw, ok := order.Details.Tasks.Raw["insurance"]; ok {
-			var task struct {
-				Complete bool `json:"complete"`
-				Required bool `json:"required"`
-			}
-			json.Unmarshal(raw, &task)
-			gates = append(gates, gate{"Insurance", task.Complete, "Customer", task.Required && !task.Complete})
+			continue
 		}
-
-		if raw, ok := order.Details.Tasks.Raw["tradeIn"]; ok {
-			var task struct {
-				Complete bool `json:"complete"`
-				Required bool `json:"required"`
-			}
-			json.Unmarshal(raw, &task)
-			gates = append(gates, gate{"Trade-In", task.Complete, "Customer", task.Required && !task.Complete})
+		if complete, ok := statuses[cg.task]; ok {
+			gates = append(gates, gate{cg.name, complete, "Customer", blocking[cg.task]})
 		}
 	}
 
@@ -1808,15 +4504,24 @@ func (m Model) renderVINDecoder(vin string) string {
 	var fields []string
 	fields = append(fields, renderVINField("Manufacturer", vinInfo.Manufacturer))
 	fields = append(fields, renderVINField("Model", vinInfo.Model))
-	fields = append(fields, renderVINField("Body Type", vinInfo.BodyType))
+	bodyType := renderVINField("Body Type", vinInfo.BodyType)
+	if vinInfo.IsRHD() {
+		// Most orders are for left-hand drive markets, so RHD may be a mistake
+		bodyType += "  " + ErrorStyle.Render("⚠ Right-Hand Drive")
+	}
+	fields = append(fields, bodyType)
 	fields = append(fields, renderVINField("Powertrain", vinInfo.Powertrain))
 	fields = append(fields, renderVINField("Model Year", vinInfo.ModelYear))
 	fields = append(fields, renderVINField("Plant", vinInfo.ManufacturingPlant))
-	fields = append(fields, renderVINField("Serial Number", vinInfo.SerialNumber))
+	checkDigit := SuccessStyle.Render("✓ Valid")
+	if !vinInfo.CheckDigitValid {
+		checkDigit = WarningStyle.Render("⚠ Invalid check digit")
+	}
+	fields = append(fields, renderVINField("Serial Number", vinInfo.SerialNumber)+"  "+checkDigit)
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		SubheadingStyle.Render("VIN Decoder"),
-		SectionBoxStyle.Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
+		m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
 	)
 }
 
@@ -1932,22 +4637,17 @@ func (m Model) renderTradeInDetails(order model.CombinedOrder) string {
 	if tradeValue > 0 {
 		// Try to get currency symbol from finalPayment task
 		symbol := ""
-		if fpRaw, fpOk := order.Details.Tasks.Raw["finalPayment"]; fpOk {
-			var fp struct {
-				CurrencyFormat *struct {
-					CurrencyCode string `json:"currencyCode"`
-				} `json:"currencyFormat"`
-			}
-			if json.Unmarshal(fpRaw, &fp) == nil && fp.CurrencyFormat != nil {
-				symbol = currencySymbol(fp.CurrencyFormat.CurrencyCode)
-			}
+		if _, currency, _ := order.GetPaymentStatus(); currency != "" {
+			symbol = currencySymbol(currency)
 		}
 		fields = append(fields, renderLabelValue("Trade-In Value", symbol+formatThousands(tradeValue)))
 	}
 
 	// Offer expiry date
 	if tradeIn.SelectedValuation != nil && tradeIn.SelectedValuation.ValuationExpireDate != "" {
-		fields = append(fields, renderLabelValue("Offer Expires", tradeIn.SelectedValuation.ValuationExpireDate))
+		fields = append(fields, fmt.Sprintf("  %s %s",
+			LabelStyle.Render("Offer Expires:"),
+			renderTradeInExpiry(tradeIn.SelectedValuation.ValuationExpireDate, timeNow())))
 	}
 
 	if len(fields) == 0 {
@@ -1956,10 +4656,74 @@ func (m Model) renderTradeInDetails(order model.CombinedOrder) string {
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		SubheadingStyle.Render("Trade-In Details"),
-		SectionBoxStyle.Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
+		m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
+	)
+}
+
+// renderPreOwnedDetails renders the model year and condition of a used
+// inventory vehicle, or "" for new vehicles
+func (m Model) renderPreOwnedDetails(order model.CombinedOrder) string {
+	if !order.GetIsUsed() {
+		return ""
+	}
+
+	year, condition := order.GetUsedVehicleDetails()
+	var fields []string
+	if year > 0 {
+		fields = append(fields, renderLabelValue("Model Year", strconv.Itoa(year)))
+	}
+	if condition != "" {
+		fields = append(fields, renderLabelValue("Condition", condition))
+	}
+	if len(fields) == 0 {
+		fields = append(fields, renderLabelValue("Condition", "N/A"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		SubheadingStyle.Render("Pre-Owned Vehicle"),
+		m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
+	)
+}
+
+// renderVehicleHeader renders the vehicle silhouette with the status, B2B
+// and Pre-Owned badges beside it. Without art only the B2B banner is shown.
+func (m Model) renderVehicleHeader(order model.CombinedOrder) string {
+	banner := renderB2BBanner(order)
+	art := ""
+	if !m.hideArt {
+		art = model.GetASCIIArt(order.Order.ModelCode)
+	}
+	if art == "" {
+		return banner
+	}
+
+	badges := []string{GetStatusBadgeStyle(order.Order.OrderStatus).Render(order.Order.OrderStatus)}
+	if banner != "" {
+		badges = append(badges, "", banner)
+	}
+	if order.GetIsUsed() {
+		badges = append(badges, "", PreOwnedBadgeStyle.Render("Pre-Owned"))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Center,
+		VehicleArtStyle.Render(art),
+		"  ",
+		lipgloss.JoinVertical(lipgloss.Left, badges...),
 	)
 }
 
+// renderB2BBanner renders a badge with the company name for business
+// orders, or "" for private orders
+func renderB2BBanner(order model.CombinedOrder) string {
+	if !order.Order.IsB2B {
+		return ""
+	}
+	badge := "B2B Order"
+	if order.Order.OwnerCompanyName != nil && *order.Order.OwnerCompanyName != "" {
+		badge += " · " + *order.Order.OwnerCompanyName
+	}
+	return B2BBadgeStyle.Render(badge)
+}
+
 // renderPaymentSummary renders payment information parsed from raw task JSON
 func (m Model) renderPaymentSummary(order model.CombinedOrder) string {
 	if order.Details.Tasks.Raw == nil {
@@ -1986,25 +4750,12 @@ func (m Model) renderPaymentSummary(order model.CombinedOrder) string {
 	}
 
 	// Amount due from finalPayment task
-	if raw, ok := order.Details.Tasks.Raw["finalPayment"]; ok {
-		var payment struct {
-			AmountDue      json.Number `json:"amountDue"`
-			CurrencyFormat *struct {
-				CurrencyCode string `json:"currencyCode"`
-			} `json:"currencyFormat"`
-		}
-		if err := json.Unmarshal(raw, &payment); err == nil {
-			if amountStr := payment.AmountDue.String(); amountStr != "" && amountStr != "0" {
-				amount, aErr := payment.AmountDue.Int64()
-				if aErr == nil && amount > 0 {
-					symbol := ""
-					if payment.CurrencyFormat != nil && payment.CurrencyFormat.CurrencyCode != "" {
-						symbol = currencySymbol(payment.CurrencyFormat.CurrencyCode)
-					}
-					fields = append(fields, renderLabelValue("Amount Due", symbol+formatThousands(amount)))
-				}
-			}
+	if amount, currency, _ := order.GetPaymentStatus(); amount > 0 {
+		symbol := ""
+		if currency != "" {
+			symbol = currencySymbol(currency)
 		}
+		fields = append(fields, renderLabelValue("Amount Due", symbol+formatThousands(amount)))
 	}
 
 	// Order adjustments (e.g. referral credit) from registration task
@@ -2029,20 +4780,14 @@ func (m Model) renderPaymentSummary(order model.CombinedOrder) string {
 
 			// If no symbol from registration, try to get from finalPayment
 			if symbol == "" {
-				if fpRaw, fpOk := order.Details.Tasks.Raw["finalPayment"]; fpOk {
-					var fp struct {
-						CurrencyFormat *struct {
-							CurrencyCode string `json:"currencyCode"`
-						} `json:"currencyFormat"`
-					}
-					if json.Unmarshal(fpRaw, &fp) == nil && fp.CurrencyFormat != nil {
-						symbol = currencySymbol(fp.CurrencyFormat.CurrencyCode)
-					}
+				if _, currency, _ := order.GetPaymentStatus(); currency != "" {
+					symbol = currencySymbol(currency)
 				}
 			}
 
+			// Referral credit is highlighted separately below
 			for _, adj := range reg.OrderDetails.OrderAdjustments {
-				if adj.Label != "" {
+				if adj.Label != "" && !strings.Contains(strings.ToLower(adj.Label), "referral") {
 					amount, aErr := adj.Amount.Int64()
 					if aErr == nil && amount != 0 {
 						prefix := "-"
@@ -2057,6 +4802,16 @@ func (m Model) renderPaymentSummary(order model.CombinedOrder) string {
 				}
 			}
 
+			if credit, currency := order.GetReferralCredit(); credit > 0 {
+				creditSymbol := symbol
+				if currency != "" {
+					creditSymbol = currencySymbol(currency)
+				}
+				fields = append(fields, fmt.Sprintf("  %s %s",
+					LabelStyle.Render("Referral Credit:"),
+					SuccessStyle.Render("-"+creditSymbol+formatThousands(credit))))
+			}
+
 			// Order deposit
 			if depStr := reg.OrderDetails.ReservationAmountReceived.String(); depStr != "" && depStr != "0" {
 				deposit, dErr := reg.OrderDetails.ReservationAmountReceived.Int64()
@@ -2073,7 +4828,7 @@ func (m Model) renderPaymentSummary(order model.CombinedOrder) string {
 
 	return lipgloss.JoinVertical(lipgloss.Left,
 		SubheadingStyle.Render("Payment Summary"),
-		SectionBoxStyle.Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
+		m.sectionBoxStyle().Width(m.sectionWidth()).Render(lipgloss.JoinVertical(lipgloss.Left, fields...)),
 	)
 }
 
@@ -2083,29 +4838,17 @@ type taskSortInfo struct {
 	order int
 }
 
-// renderTasksTab renders the tasks tab content
-func (m Model) renderTasksTab(order model.CombinedOrder) string {
-	var lines []string
-
-	// Delivery Readiness section
-	lines = append(lines, m.renderDeliveryGates(order))
-	lines = append(lines, "")
-
-	// Order Tasks section
-	lines = append(lines, SubheadingStyle.Render("Order Tasks:"))
-	lines = append(lines, "")
-
-	tasks := order.Details.Tasks
-
+// sortedTasks returns the order's tasks sorted by their order field (as in
+// the Tesla app), skipping the metadata keys
+func sortedTasks(order model.CombinedOrder) []taskSortInfo {
 	// Skip metadata keys
 	skipKeys := map[string]bool{
 		"state":   true,
 		"strings": true,
 	}
 
-	// Get all tasks with their order field for sorting
 	var taskList []taskSortInfo
-	for name, rawData := range tasks.Raw {
+	for name, rawData := range order.Details.Tasks.Raw {
 		if skipKeys[name] {
 			continue
 		}
@@ -2117,29 +4860,50 @@ func (m Model) renderTasksTab(order model.CombinedOrder) string {
 		taskList = append(taskList, taskSortInfo{name: name, order: orderInfo.Order})
 	}
 
-	// Sort by order field (as in Tesla app)
 	sort.Slice(taskList, func(i, j int) bool {
-		return taskList[i].order < taskList[j].order
+		if taskList[i].order != taskList[j].order {
+			return taskList[i].order < taskList[j].order
+		}
+		return taskList[i].name < taskList[j].name
 	})
+	return taskList
+}
+
+// taskActionLabel describes a task's action link, preferring the button text
+func taskActionLabel(task *model.TeslaTask) string {
+	if task.Card != nil && task.Card.ButtonText != nil && task.Card.ButtonText.CTA != "" {
+		return task.Card.ButtonText.CTA
+	}
+	return "link"
+}
+
+// renderTasksTab renders the tasks tab content
+func (m Model) renderTasksTab(order model.CombinedOrder) string {
+	var lines []string
+
+	// Delivery Readiness section
+	lines = append(lines, m.renderDeliveryGates(order))
+	lines = append(lines, "")
+
+	// Order Tasks section
+	lines = append(lines, SubheadingStyle.Render("Order Tasks:"))
+	lines = append(lines, "")
 
-	// Render each task from raw data
-	for _, task := range taskList {
+	// Render each task from raw data, in the order shown in the Tesla app
+	for i, task := range sortedTasks(order) {
 		name := task.name
-		rawData := tasks.Raw[name]
+		rawData := order.Details.Tasks.Raw[name]
 
-		// Parse the task to get completion status and title
-		var taskData struct {
-			Complete bool `json:"complete"`
-			Enabled  bool `json:"enabled"`
-			Card     *struct {
-				Title    string `json:"title"`
-				Subtitle string `json:"subtitle"`
-			} `json:"card"`
+		cursor := "  "
+		if i == m.taskCursor {
+			cursor = ChangedValueStyle.Render("> ")
 		}
 
+		// Parse the task to get completion status and title
+		var taskData model.TeslaTask
 		if err := json.Unmarshal(rawData, &taskData); err != nil {
 			// If we can't parse, just show the name
-			lines = append(lines, TaskIncompleteStyle.Render(fmt.Sprintf("  ○ %s", formatTaskName(name))))
+			lines = append(lines, cursor+TaskIncompleteStyle.Render(fmt.Sprintf("○ %s", formatTaskName(name))))
 			continue
 		}
 
@@ -2157,7 +4921,7 @@ func (m Model) renderTasksTab(order model.CombinedOrder) string {
 		taskLabel := formatTaskName(name)
 
 		// Build the line with task name and status
-		line := style.Render(fmt.Sprintf("  %s %s%s", icon, taskLabel, statusText))
+		line := cursor + style.Render(fmt.Sprintf("%s %s%s", icon, taskLabel, statusText))
 
 		// Only show card details for incomplete tasks
 		if !taskData.Complete && taskData.Card != nil {
@@ -2173,6 +4937,11 @@ func (m Model) renderTasksTab(order model.CombinedOrder) string {
 			}
 		}
 
+		// Point out the action link of the focused task
+		if i == m.taskCursor && taskData.GetActionURL() != "" {
+			line += "\n" + HelpStyle.Render("      o: open "+taskActionLabel(&taskData))
+		}
+
 		lines = append(lines, line)
 		lines = append(lines, "") // Add spacing between tasks
 	}
@@ -2184,6 +4953,51 @@ func (m Model) renderTasksTab(order model.CombinedOrder) string {
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderTaskDetail renders every card field of a task, for the overlay
+// opened with 'T' on the Tasks tab
+func (m Model) renderTaskDetail(order model.CombinedOrder, name string) string {
+	lines := []string{SubheadingStyle.Render(formatTaskName(name)), ""}
+
+	var task model.TeslaTask
+	if err := json.Unmarshal(order.Details.Tasks.Raw[name], &task); err != nil || task.Card == nil {
+		lines = append(lines, TaskIncompleteStyle.Render("  No card details for this task"))
+	} else {
+		card := task.Card
+		target := task.GetActionURL()
+		if target == "" {
+			target = card.Target
+		}
+		cta := ""
+		if card.ButtonText != nil {
+			cta = card.ButtonText.CTA
+		}
+		fields := []struct{ label, value string }{
+			{"Title", card.Title},
+			{"Subtitle", card.Subtitle},
+			{"Message Title", card.MessageTitle},
+			{"Message", card.MessageBody},
+			{"Button", cta},
+			{"Target URL", target},
+		}
+		// Long values wrap beside the label column
+		valueWidth := m.viewport.Width - lipgloss.Width(LabelStyle.Render("")) - 3
+		for _, f := range fields {
+			value := f.value
+			if value == "" {
+				value = "-"
+			}
+			if valueWidth > 0 {
+				value = lipgloss.NewStyle().Width(valueWidth).Render(value)
+			}
+			lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+				"  ", LabelStyle.Render(f.label+":"), " ", ValueStyle.Render(value)))
+		}
+	}
+
+	lines = append(lines, "", HelpStyle.Render("esc: close"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
 // formatTaskName converts camelCase task names to readable format
 func formatTaskName(name string) string {
 	// Map of known task names to readable versions
@@ -2217,47 +5031,94 @@ func formatTaskName(name string) string {
 	return result.String()
 }
 
-// renderCountdown renders a countdown to the delivery appointment
-func (m Model) renderCountdown(order model.CombinedOrder) string {
-	appt := order.GetParsedAppointment()
-	if appt == nil {
-		return ""
+// tradeInExpiryWarningDays is how close the trade-in offer expiry must be
+// before it is shown as a countdown
+const tradeInExpiryWarningDays = 7
+
+// parseTradeInExpiry parses a trade-in offer expiry date, or returns nil
+// when it is not a date
+func parseTradeInExpiry(dateStr string) *time.Time {
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, dateStr); err == nil {
+			return &t
+		}
 	}
+	return nil
+}
 
-	// Try to parse the date - format: "August 15, 2024"
-	dateStr := appt.Date
-	if appt.Time != "" {
-		dateStr = appt.Date + " " + appt.Time
+// renderTradeInExpiry renders the trade-in offer expiry: the date itself, a
+// countdown when it is less than a week away, or a notice once it passed
+func renderTradeInExpiry(dateStr string, now time.Time) string {
+	expiry := parseTradeInExpiry(dateStr)
+	if expiry == nil {
+		return ValueStyle.Render(dateStr)
 	}
+	// Count calendar days, so the time of day doesn't matter
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(expiry.Year(), expiry.Month(), expiry.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(day.Sub(today).Hours() / 24)
+
+	switch {
+	case days < 0:
+		return ErrorStyle.Render("Offer expired")
+	case days == 0:
+		return ChangedValueStyle.Render("⚠ Expires today!")
+	case days == 1:
+		return ChangedValueStyle.Render("⚠ Expires in 1 day!")
+	case days < tradeInExpiryWarningDays:
+		return ChangedValueStyle.Render(fmt.Sprintf("⚠ Expires in %d days!", days))
+	}
+	return ValueStyle.Render(dateStr)
+}
 
-	// Try common date formats
-	var targetTime time.Time
-	var err error
-	formats := []string{
-		"January 2, 2006 3:04 PM",
-		"January 2, 2006 03:04 PM",
-		"January 2, 2006",
-		"Jan 2, 2006 3:04 PM",
-		"Jan 2, 2006",
-		"2006-01-02",
+// formatAppointmentDate formats an appointment's date in the configured date
+// format, keeping the API text when the date cannot be parsed
+func (m Model) formatAppointmentDate(appt *model.AppointmentDetails) string {
+	date, ok := model.ParseAppointmentTime(&model.AppointmentDetails{Date: appt.Date})
+	if !ok {
+		return appt.Date
 	}
+	return format.FormatDate(date, m.dateFormat)
+}
 
-	for _, format := range formats {
-		targetTime, err = time.Parse(format, dateStr)
-		if err == nil {
-			break
-		}
+// countdownTarget returns the delivery appointment time in the configured
+// timezone, falling back to UTC
+func (m Model) countdownTarget(order model.CombinedOrder) (time.Time, bool) {
+	appt := order.GetParsedAppointment()
+	if appt == nil {
+		return time.Time{}, false
+	}
+	loc := m.location
+	if loc == nil {
+		loc = time.UTC
 	}
+	return model.ParseAppointmentTimeIn(appt, loc)
+}
 
-	if err != nil {
+// renderCountdown renders a countdown to the delivery appointment. While the
+// live ticker runs it counts from the last tick and shows seconds
+func (m Model) renderCountdown(order model.CombinedOrder) string {
+	targetTime, ok := m.countdownTarget(order)
+	if !ok {
 		return ""
 	}
 
-	now := time.Now()
+	// Appointment times are local to the delivery center; without a
+	// configured timezone we assume UTC and say so
+	tzHint := ""
+	if m.location == nil {
+		tzHint = lipgloss.NewStyle().Foreground(Muted).Render("(UTC – configure timezone in settings)")
+	}
+
+	now := m.countdownTick
+	live := !now.IsZero()
+	if !live {
+		now = timeNow()
+	}
 	diff := targetTime.Sub(now)
 
 	if diff <= 0 {
-		return SectionBoxStyle.Width(m.sectionWidth()).Render(
+		return m.sectionBoxStyle().Width(m.sectionWidth()).Render(
 			SuccessStyle.Render("  It's Delivery Day! Congratulations!  "),
 		)
 	}
@@ -2274,13 +5135,17 @@ func (m Model) renderCountdown(order model.CombinedOrder) string {
 	} else {
 		countdown = fmt.Sprintf("%dm", minutes)
 	}
+	if live {
+		countdown += fmt.Sprintf(" %ds", int(diff.Seconds())%60)
+	}
 
 	content := fmt.Sprintf("  Delivery in %s  ", ChangedValueStyle.Render(countdown))
-	return SectionBoxStyle.Width(m.sectionWidth()).Render(
-		lipgloss.JoinVertical(lipgloss.Center,
-			SubheadingStyle.Render("Delivery Countdown"),
-			content,
-		),
+	lines := []string{SubheadingStyle.Render("Delivery Countdown"), content}
+	if tzHint != "" {
+		lines = append(lines, tzHint)
+	}
+	return m.sectionBoxStyle().Width(m.sectionWidth()).Render(
+		lipgloss.JoinVertical(lipgloss.Center, lines...),
 	)
 }
 
@@ -2298,8 +5163,10 @@ func (m Model) renderChecklistTab(order model.CombinedOrder) string {
 		}
 	}
 
+	sections := storage.ChecklistFor(order.Order.ModelCode)
+
 	// Progress summary
-	completed, total := storage.CountCompleted(checkState.Checked)
+	completed, total := storage.CountCompletedIn(sections, checkState.Checked)
 	progressPct := 0
 	if total > 0 {
 		progressPct = completed * 100 / total
@@ -2321,16 +5188,48 @@ func (m Model) renderChecklistTab(order model.CombinedOrder) string {
 		progress.WithSolidFill("#3B82F6"),
 		progress.WithWidth(40),
 		progress.WithoutPercentage(),
+		progress.WithColorProfile(lipgloss.ColorProfile()),
 	)
-	lines = append(lines, "  "+prog.ViewAs(pct))
+	if !m.minimalMode {
+		lines = append(lines, "  "+prog.ViewAs(pct))
+	}
 	lines = append(lines, "")
 
 	// Render sections
-	itemIdx := 0
-	for _, section := range storage.DeliveryChecklist {
-		lines = append(lines, SubheadingStyle.Render("  "+section.Title))
+	rowIdx := 0
+	for _, section := range sections {
+		expanded := m.isSectionExpanded(section.Title)
+
+		indicator := "▾"
+		if !expanded {
+			indicator = "▸"
+		}
+
+		sectionDone := 0
+		for _, item := range section.Items {
+			if checkState.Checked[item.ID] {
+				sectionDone++
+			}
+		}
+
+		cursor := "  "
+		if rowIdx == m.checklistCursor {
+			cursor = ChangedValueStyle.Render("> ")
+		}
+		rowIdx++
+
+		lines = append(lines, fmt.Sprintf("%s%s %s %s",
+			cursor,
+			SubheadingStyle.Render(indicator),
+			SubheadingStyle.Render(section.Title),
+			lipgloss.NewStyle().Foreground(Muted).Render(fmt.Sprintf("(%d/%d)", sectionDone, len(section.Items))),
+		))
 		lines = append(lines, "")
 
+		if !expanded {
+			continue
+		}
+
 		for _, item := range section.Items {
 			checked := checkState.Checked[item.ID]
 
@@ -2345,23 +5244,23 @@ func (m Model) renderChecklistTab(order model.CombinedOrder) string {
 			}
 
 			cursor := "  "
-			if itemIdx == m.checklistCursor {
+			if rowIdx == m.checklistCursor {
 				cursor = ChangedValueStyle.Render("> ")
 			}
 
 			lines = append(lines, fmt.Sprintf("  %s%s %s", cursor, icon, style.Render(item.Text)))
-			itemIdx++
+			rowIdx++
 		}
 		lines = append(lines, "")
 	}
 
-	lines = append(lines, HelpStyle.Render("  ↑/↓: navigate • enter/space: toggle • tab: next tab"))
+	lines = append(lines, HelpStyle.Render("  ↑/↓: navigate • enter/space: toggle item or section • ctrl+z: undo • e: export • R: reset • tab: next tab"))
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
-// renderJSONTab renders the JSON tab content
-func (m Model) renderJSONTab(order model.CombinedOrder) string {
+// jsonTabText returns the indented JSON shown in the JSON tab, without styling
+func jsonTabText(order model.CombinedOrder) (string, error) {
 	// Create a combined view with order info and raw API response
 	combined := map[string]interface{}{
 		"order": order.Order,
@@ -2376,126 +5275,309 @@ func (m Model) renderJSONTab(order model.CombinedOrder) string {
 
 	// Marshal with indentation
 	jsonBytes, err := json.MarshalIndent(combined, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// errNoRawJSON is returned in raw view mode for orders without the original
+// API response, such as cached or demo orders
+var errNoRawJSON = errors.New("no raw API response available for this order")
+
+// rawJSONText returns the details API response as received, without the
+// order merged in
+func rawJSONText(order model.CombinedOrder) (string, error) {
+	if order.Details.RawJSON == nil {
+		return "", errNoRawJSON
+	}
+	jsonBytes, err := json.MarshalIndent(order.Details.RawJSON, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// jsonText returns the JSON shown in the JSON tab: the raw API response in
+// raw view mode ('v'), the combined order otherwise
+func (m Model) jsonText(order model.CombinedOrder) (string, error) {
+	if m.rawViewMode {
+		return rawJSONText(order)
+	}
+	return jsonTabText(order)
+}
+
+// renderJSONTab renders the JSON tab content. Lines matching the search
+// query are shown with the matches highlighted instead of syntax colours.
+func (m Model) renderJSONTab(order model.CombinedOrder) string {
+	text, err := m.jsonText(order)
+	if errors.Is(err, errNoRawJSON) {
+		return HelpStyle.Render("No raw API response available for this order.")
+	}
 	if err != nil {
 		return ErrorStyle.Render("Failed to render JSON: " + err.Error())
 	}
 
-	return highlightJSON(string(jsonBytes))
+	if m.jsonSearchQuery == "" {
+		return highlightJSON(text)
+	}
+
+	lines := strings.Split(text, "\n")
+	matched := make(map[int]bool, len(m.jsonSearchMatches))
+	for _, i := range m.jsonSearchMatches {
+		matched[i] = true
+	}
+	for i, line := range lines {
+		if matched[i] {
+			lines[i] = highlightSearchMatches(line, m.jsonSearchQuery)
+		} else {
+			lines[i] = highlightJSON(line)
+		}
+	}
+	return strings.Join(lines, "\n")
 }
 
-// highlightJSON applies syntax highlighting to JSON output
+// highlightJSON applies syntax highlighting to JSON output. Each line is
+// matched once by jsonLineRe; lines without a key are left as they are.
 func highlightJSON(jsonStr string) string {
-	lines := strings.Split(jsonStr, "\n")
-	var result []string
+	var b strings.Builder
+	b.Grow(len(jsonStr) * 2)
 
-	for _, line := range lines {
-		highlighted := line
+	for i, line := range strings.Split(jsonStr, "\n") {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
 
-		// Check for key: value patterns
-		if loc := jsonKeyRe.FindStringSubmatchIndex(line); loc != nil {
-			indent := line[loc[2]:loc[3]]
-			key := line[loc[4]:loc[5]]
-			rest := line[loc[1]:]
+		loc := jsonLineRe.FindStringSubmatchIndex(line)
+		if loc == nil {
+			b.WriteString(line)
+			continue
+		}
 
-			// Highlight the value part
-			var styledValue string
-			switch {
-			case jsonNullRe.MatchString(line):
-				valLoc := jsonNullRe.FindStringSubmatchIndex(line)
-				styledValue = line[loc[1]:valLoc[2]] + JSONNullStyle.Render(line[valLoc[2]:valLoc[3]]) + line[valLoc[3]:]
-			case jsonBoolRe.MatchString(line):
-				valLoc := jsonBoolRe.FindStringSubmatchIndex(line)
-				styledValue = line[loc[1]:valLoc[2]] + JSONBoolStyle.Render(line[valLoc[2]:valLoc[3]]) + line[valLoc[3]:]
-			case jsonNumberRe.MatchString(line):
-				valLoc := jsonNumberRe.FindStringSubmatchIndex(line)
-				styledValue = line[loc[1]:valLoc[2]] + JSONNumberStyle.Render(line[valLoc[2]:valLoc[3]]) + line[valLoc[3]:]
-			case jsonStringRe.MatchString(line):
-				valLoc := jsonStringRe.FindStringSubmatchIndex(line)
-				// valLoc[2]:valLoc[3] is string content; include surrounding quotes
-				quoteStart := valLoc[2] - 1
-				quoteEnd := valLoc[3] + 1
-				styledValue = line[loc[1]:quoteStart] + JSONStringStyle.Render(line[quoteStart:quoteEnd]) + line[quoteEnd:]
-			default:
-				styledValue = rest
+		b.WriteString(line[loc[2]:loc[3]])
+		b.WriteString(JSONKeyStyle.Render(line[loc[4]:loc[5]]))
+		b.WriteString(line[loc[6]:loc[7]])
+		rest := line[loc[7]:]
+		for _, v := range jsonValueStyles {
+			if start := loc[2*v.group]; start >= 0 {
+				end := loc[2*v.group+1]
+				b.WriteString(v.style.Render(line[start:end]))
+				rest = line[end:]
+				break
 			}
-
-			highlighted = indent + JSONKeyStyle.Render(fmt.Sprintf(`"%s"`, key)) + styledValue
 		}
-
-		result = append(result, highlighted)
+		b.WriteString(rest)
 	}
 
-	return strings.Join(result, "\n")
+	return b.String()
 }
 
 // renderHistoryTab renders the history tab content
 func (m Model) renderHistoryTab(order model.CombinedOrder) string {
-	var lines []string
-	lines = append(lines, SubheadingStyle.Render("Order History:"))
-	lines = append(lines, "")
-
-	// Load history from storage (or demo data)
-	var history *model.OrderHistory
-	var err error
+	content, _ := m.renderHistory(order)
+	return content
+}
 
+// loadOrderHistory returns the stored history for an order (or demo data)
+func (m Model) loadOrderHistory(order model.CombinedOrder) (*model.OrderHistory, error) {
 	if m.demoMode && m.demoHistory != nil {
-		history = m.demoHistory[order.Order.ReferenceNumber]
+		history := m.demoHistory[order.Order.ReferenceNumber]
 		if history == nil {
 			history = &model.OrderHistory{ReferenceNumber: order.Order.ReferenceNumber}
 		}
-	} else {
-		history, err = m.history.LoadHistory(order.Order.ReferenceNumber)
+		return history, nil
+	}
+	return m.history.LoadHistory(order.Order.ReferenceNumber)
+}
+
+// orderTimeline holds an order's timeline as computed from its history, so
+// the Details tab doesn't read the history on every render
+type orderTimeline struct {
+	timeline   model.OrderTimeline
+	timestamps map[string]time.Time
+}
+
+// newOrderTimeline computes the timeline of an order from its history
+func newOrderTimeline(order model.CombinedOrder, history *model.OrderHistory) orderTimeline {
+	return orderTimeline{
+		timeline:   model.ComputeTimeline(order, history),
+		timestamps: model.GetStageTimestamps(history),
+	}
+}
+
+// buildTimelines computes the timelines of the given orders, keyed by
+// reference number
+func (m Model) buildTimelines(orders []model.CombinedOrder) map[string]orderTimeline {
+	timelines := make(map[string]orderTimeline, len(orders))
+	for _, order := range orders {
+		history, err := m.loadOrderHistory(order)
 		if err != nil {
-			return ErrorStyle.Render("Failed to load history: " + err.Error())
+			m.logger.Error("order history", "reference", order.Order.ReferenceNumber, "error", err.Error())
+		}
+		timelines[order.Order.ReferenceNumber] = newOrderTimeline(order, history)
+	}
+	return timelines
+}
+
+// nextChangeIndex returns the index of the next snapshot that differs from
+// its predecessor, moving down the newest-first History list (older) when
+// forward is set and up (newer) otherwise. current is -1 when nothing is
+// focused.
+func nextChangeIndex(history *model.OrderHistory, current int, forward bool) (int, bool) {
+	snapshots := history.Snapshots
+	hasChanges := func(i int) bool {
+		return i > 0 && len(model.CompareOrders(snapshots[i-1].Data, snapshots[i].Data)) > 0
+	}
+
+	if forward {
+		start := len(snapshots) - 1
+		if current >= 0 && current < len(snapshots) {
+			start = current - 1
+		}
+		for i := start; i > 0; i-- {
+			if hasChanges(i) {
+				return i, true
+			}
+		}
+		return current, false
+	}
+
+	if current < 0 {
+		return current, false
+	}
+	for i := current + 1; i < len(snapshots); i++ {
+		if hasChanges(i) {
+			return i, true
 		}
 	}
+	return current, false
+}
+
+// renderHistory renders the history tab content and returns the line at which
+// the focused snapshot starts (0 when nothing is focused)
+func (m Model) renderHistory(order model.CombinedOrder) (string, int) {
+	var lines []string
+	lines = append(lines, SubheadingStyle.Render("Order History:"))
+	lines = append(lines, "")
+
+	history, err := m.loadOrderHistory(order)
+	if err != nil {
+		return ErrorStyle.Render("Failed to load history: " + err.Error()), 0
+	}
 
 	if len(history.Snapshots) == 0 {
 		lines = append(lines, HelpStyle.Render("No history available yet."))
 		lines = append(lines, HelpStyle.Render("History is recorded when changes are detected."))
-		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+		return lipgloss.JoinVertical(lipgloss.Left, lines...), 0
 	}
 
+	focusLine := 0
+
 	// Show snapshots in reverse chronological order (newest first)
 	for i := len(history.Snapshots) - 1; i >= 0; i-- {
 		snapshot := history.Snapshots[i]
 		relTime := relativeTime(snapshot.Timestamp)
 		fullTime := snapshot.Timestamp.Format("Jan 02, 2006 at 03:04 PM")
+		focused := i == m.historyIndex
+
+		var block []string
 
 		// Snapshot header with relative time and full timestamp
-		if i == len(history.Snapshots)-1 {
-			lines = append(lines, ValueStyle.Render(fmt.Sprintf("● %s (Current)", relTime)))
-			lines = append(lines, HelpStyle.Render(fmt.Sprintf("  %s", fullTime)))
-		} else {
-			lines = append(lines, HelpStyle.Render(fmt.Sprintf("○ %s", relTime)))
-			lines = append(lines, HelpStyle.Render(fmt.Sprintf("  %s", fullTime)))
+		switch {
+		case focused && i == len(history.Snapshots)-1:
+			block = append(block, ChangedValueStyle.Render(fmt.Sprintf("● %s (Current)", relTime)))
+		case focused:
+			block = append(block, ChangedValueStyle.Render(fmt.Sprintf("○ %s", relTime)))
+		case i == len(history.Snapshots)-1:
+			block = append(block, ValueStyle.Render(fmt.Sprintf("● %s (Current)", relTime)))
+		default:
+			block = append(block, HelpStyle.Render(fmt.Sprintf("○ %s", relTime)))
 		}
+		block = append(block, HelpStyle.Render(fmt.Sprintf("  %s", fullTime)))
 
 		// Show key details at this snapshot
 		data := snapshot.Data
-		lines = append(lines, HelpStyle.Render(fmt.Sprintf("  Status: %s", data.Order.OrderStatus)))
-		lines = append(lines, HelpStyle.Render(fmt.Sprintf("  VIN: %s", data.Order.GetVIN())))
-		lines = append(lines, HelpStyle.Render(fmt.Sprintf("  Delivery Window: %s", data.GetDeliveryWindow())))
+		block = append(block, HelpStyle.Render(fmt.Sprintf("  Status: %s", data.Order.OrderStatus)))
+		block = append(block, HelpStyle.Render(fmt.Sprintf("  VIN: %s", data.Order.GetVIN())))
+		block = append(block, HelpStyle.Render(fmt.Sprintf("  Delivery Window: %s", data.GetDeliveryWindow())))
 
 		// Show what changed compared to previous snapshot
 		if i > 0 {
 			prevSnapshot := history.Snapshots[i-1]
 			changes := m.compareSnapshots(prevSnapshot.Data, snapshot.Data)
 			if len(changes) > 0 {
-				lines = append(lines, DiffAddedStyle.Render("    Changes:"))
-				for _, change := range changes {
-					lines = append(lines, fmt.Sprintf("      %s %s → %s",
-						DiffAddedStyle.Render("•"),
-						change.Field,
-						DiffAddedStyle.Render(fmt.Sprintf("%v", change.NewValue)),
-					))
-				}
+				block = append(block, DiffAddedStyle.Render("    Changes:"))
+				block = append(block, renderSnapshotDiff(changes))
 			}
 		}
 
+		if focused {
+			focusLine = lipgloss.Height(lipgloss.JoinVertical(lipgloss.Left, lines...))
+			lines = append(lines, HistoryFocusStyle.Render(lipgloss.JoinVertical(lipgloss.Left, block...)))
+		} else {
+			lines = append(lines, block...)
+		}
+
 		lines = append(lines, "") // spacing between snapshots
 	}
 
+	return lipgloss.JoinVertical(lipgloss.Left, lines...), focusLine
+}
+
+// diffCategory groups changed fields for the History tab
+type diffCategory struct {
+	name   string
+	icon   string
+	fields []string
+}
+
+// diffCategories lists the History tab diff groups in display order. Fields
+// not listed here fall under order metadata.
+var diffCategories = []diffCategory{
+	{"Order", "📋", []string{"Order Status", "VIN", "Vehicle Options", "Reservation Date", "Order Booked Date", "License Plate", "Insurance"}},
+	{"Delivery", "🚗", []string{"Delivery Window", "Delivery Appointment", "ETA to Delivery Center", "Vehicle Location", "Delivery Method", "Delivery Center", "Odometer"}},
+	{"Payment", "💳", []string{"Amount Due"}},
+}
+
+// renderSnapshotDiff renders diffs grouped by category with old and new values
+func renderSnapshotDiff(diffs []model.OrderDiff) string {
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	categoryOf := func(field string) int {
+		for i, cat := range diffCategories {
+			for _, f := range cat.fields {
+				if f == field {
+					return i
+				}
+			}
+		}
+		return 0
+	}
+
+	grouped := make([][]model.OrderDiff, len(diffCategories))
+	for _, diff := range diffs {
+		i := categoryOf(diff.Field)
+		grouped[i] = append(grouped[i], diff)
+	}
+
+	var lines []string
+	for i, cat := range diffCategories {
+		if len(grouped[i]) == 0 {
+			continue
+		}
+		lines = append(lines, "      "+ValueStyle.Render(cat.icon+" "+cat.name))
+		for _, diff := range grouped[i] {
+			lines = append(lines, fmt.Sprintf("        %s %s: %s → %s",
+				DiffAddedStyle.Render("•"),
+				diff.Field,
+				OldValueStyle.Render(fmt.Sprintf("%v", diff.OldValue)),
+				DiffAddedStyle.Render(fmt.Sprintf("%v", diff.NewValue)),
+			))
+		}
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
@@ -0,0 +1,2980 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/export"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/format"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+// newTestModel creates a Model backed by a MockClient and temporary storage
+func newTestModel(t *testing.T, client *api.MockClient) Model {
+	t.Helper()
+
+	tempDir, err := os.MkdirTemp("", "tesla-tui-app-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tempDir) })
+
+	hist, err := storage.NewHistory(tempDir)
+	if err != nil {
+		t.Fatalf("NewHistory() error = %v", err)
+	}
+	cl, err := storage.NewChecklist(tempDir)
+	if err != nil {
+		t.Fatalf("NewChecklist() error = %v", err)
+	}
+
+	return New(nil, client, hist, cl)
+}
+
+// collectMsgs runs a command and any batched sub-commands, returning the
+// resulting messages. Tick commands are skipped so the test does not block.
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMsgs(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestUpdate_OrdersLoadedMsg(t *testing.T) {
+	client := api.NewMockClient(nil)
+	m := newTestModel(t, client)
+	m.view = ViewOrders
+	m.loading = true
+
+	orders := demo.GetDemoOrders()
+	diffs := map[string][]model.OrderDiff{
+		orders[0].Order.ReferenceNumber: {{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"}},
+	}
+
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: orders, Diffs: diffs})
+	got := updated.(Model)
+
+	if got.loading {
+		t.Error("loading should be false after OrdersLoadedMsg")
+	}
+	if len(got.orders) != len(orders) {
+		t.Errorf("orders = %d, want %d", len(got.orders), len(orders))
+	}
+	if got.toastMessage != "✓ Refreshed - 1 order(s) with changes" {
+		t.Errorf("toastMessage = %q", got.toastMessage)
+	}
+	if got.lastRefresh.IsZero() {
+		t.Error("lastRefresh should be set")
+	}
+	if client.CallCount("GetAllOrderData") != 0 {
+		t.Error("OrdersLoadedMsg should not trigger an API call")
+	}
+}
+
+func TestRenderQuickSummary(t *testing.T) {
+	orders := demo.GetDemoOrders()
+	ref := orders[0].Order.ReferenceNumber
+
+	want := "1 active order • VIN assigned • Payment due • Delivery: June 15"
+	if got := renderQuickSummary(orders, nil); got != want {
+		t.Errorf("renderQuickSummary() = %q, want %q", got, want)
+	}
+
+	diffs := map[string][]model.OrderDiff{ref: {{Field: "VIN"}, {Field: "Order Status"}}}
+	if got := renderQuickSummary(orders, diffs); got != want+" • 2 changes" {
+		t.Errorf("renderQuickSummary() with changes = %q", got)
+	}
+
+	delivered := demo.GetDemoOrders()
+	delivered[0].Order.OrderStatus = "DELIVERED"
+	if got := renderQuickSummary(delivered, nil); got != "All orders delivered" {
+		t.Errorf("renderQuickSummary() for delivered orders = %q", got)
+	}
+
+	ready := demo.GetDemoOrders()
+	ready[0].Order.OrderStatus = "READY_FOR_DELIVERY"
+	if got := renderQuickSummary(ready, nil); !strings.HasPrefix(got, "1 active order") {
+		t.Errorf("renderQuickSummary() for an order ready for delivery = %q, want it counted as active", got)
+	}
+
+	if got := renderQuickSummary(nil, nil); got != "" {
+		t.Errorf("renderQuickSummary() without orders = %q, want empty", got)
+	}
+}
+
+func TestViewOrders_QuickSummaryTruncates(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 80, 40
+	m.view = ViewOrders
+	m.orders = demo.GetDemoOrders()
+	m.orders[0].Details.Tasks.Scheduling.ApptDateTimeAddressStr = ""
+	m.orders[0].Details.Tasks.Scheduling.DeliveryWindowDisplay = "Between the end of May and the middle of June 2026"
+
+	view := m.View()
+	if !strings.Contains(view, "1 active order • VIN") || !strings.Contains(view, "…") {
+		t.Errorf("orders view should show the truncated summary:\n%s", view)
+	}
+}
+
+func TestUpdate_AutoRefreshTickMsg(t *testing.T) {
+	orders := demo.GetDemoOrders()
+	client := api.NewMockClient(orders)
+	m := newTestModel(t, client).WithAutoRefresh(time.Hour)
+	m.view = ViewOrders
+	m.tokens = &model.TeslaTokens{AccessToken: "test", ExpiresAt: time.Now().Add(time.Hour)}
+
+	updated, cmd := m.Update(AutoRefreshTickMsg(time.Now()))
+	got := updated.(Model)
+
+	if !got.loading {
+		t.Error("loading should be true after AutoRefreshTickMsg")
+	}
+
+	var loaded *OrdersLoadedMsg
+	for _, msg := range collectMsgs(cmd) {
+		if l, ok := msg.(OrdersLoadedMsg); ok {
+			loaded = &l
+		}
+	}
+	if loaded == nil {
+		t.Fatal("AutoRefreshTickMsg should produce an OrdersLoadedMsg")
+	}
+	if loaded.Error != nil {
+		t.Errorf("OrdersLoadedMsg.Error = %v", loaded.Error)
+	}
+	if len(loaded.Orders) != len(orders) {
+		t.Errorf("loaded orders = %d, want %d", len(loaded.Orders), len(orders))
+	}
+	if client.CallCount("GetAllOrderData") != 1 {
+		t.Errorf("GetAllOrderData called %d times, want 1", client.CallCount("GetAllOrderData"))
+	}
+}
+
+func TestUpdate_AutoRefreshTickMsg_SkipsWhenNotOnOrders(t *testing.T) {
+	client := api.NewMockClient(demo.GetDemoOrders())
+	m := newTestModel(t, client).WithAutoRefresh(time.Hour)
+	m.view = ViewHelp
+	m.tokens = &model.TeslaTokens{AccessToken: "test", ExpiresAt: time.Now().Add(time.Hour)}
+
+	updated, _ := m.Update(AutoRefreshTickMsg(time.Now()))
+	if updated.(Model).loading {
+		t.Error("should not start loading outside the orders view")
+	}
+	if client.CallCount("GetAllOrderData") != 0 {
+		t.Error("GetAllOrderData should not be called outside the orders view")
+	}
+}
+
+func TestUpdate_ErrMsg(t *testing.T) {
+	client := api.NewMockClient(nil)
+	m := newTestModel(t, client)
+	m.loading = true
+
+	updated, cmd := m.Update(ErrMsg{errors.New("boom")})
+	got := updated.(Model)
+
+	if got.loading {
+		t.Error("loading should be false after ErrMsg")
+	}
+	if got.err == nil || got.err.Error() != "boom" {
+		t.Errorf("err = %v, want boom", got.err)
+	}
+	if cmd != nil {
+		t.Error("ErrMsg should not return a command")
+	}
+}
+
+func TestLoadOrders_Error(t *testing.T) {
+	client := api.NewMockClient(nil)
+	client.Err = errors.New("network down")
+	m := newTestModel(t, client)
+
+	msg := m.loadOrders()
+	loaded, ok := msg.(OrdersLoadedMsg)
+	if !ok {
+		t.Fatalf("loadOrders() returned %T, want OrdersLoadedMsg", msg)
+	}
+	if loaded.Error == nil {
+		t.Error("OrdersLoadedMsg.Error should be set")
+	}
+}
+
+func TestSettingsView_CycleAndSave(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-settings-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.config = cfg
+	m.view = ViewOrders
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	m = updated.(Model)
+	if m.view != ViewSettings {
+		t.Fatalf("view = %v, want ViewSettings", m.view)
+	}
+
+	// Move to "Notifications" and toggle it off
+	for i := 0; i < 2; i++ {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		m = updated.(Model)
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	if m.view != ViewOrders {
+		t.Errorf("view = %v, want ViewOrders after saving", m.view)
+	}
+	if m.notificationsEnabled {
+		t.Error("notificationsEnabled should be false after toggling")
+	}
+
+	collectMsgs(cmd)
+
+	reloaded, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() reload error = %v", err)
+	}
+	if reloaded.Settings().NotificationsEnabled {
+		t.Error("NotificationsEnabled should be persisted as false")
+	}
+}
+
+func TestStatsView_OpenAndClose(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	m = updated.(Model)
+	if m.view != ViewStats {
+		t.Fatalf("view = %v, want ViewStats", m.view)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Total orders", "Status: BOOKED"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("stats view missing %q", want)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.view != ViewOrders {
+		t.Errorf("view = %v, want ViewOrders after esc", m.view)
+	}
+}
+
+func TestOrdersKeys_WatchToggle(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-watch-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.config = cfg
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	ref := m.orders[0].Order.ReferenceNumber
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	if !m.watched[ref] {
+		t.Fatalf("order %s should be watched", ref)
+	}
+	if !strings.Contains(m.View(), "👁") {
+		t.Error("watched order should show the watch icon")
+	}
+	collectMsgs(cmd)
+
+	reloaded, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() reload error = %v", err)
+	}
+	if got := reloaded.Settings().WatchedOrders; len(got) != 1 || got[0] != ref {
+		t.Errorf("WatchedOrders = %v, want [%s]", got, ref)
+	}
+
+	updated, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	m = updated.(Model)
+	if m.watched[ref] {
+		t.Errorf("order %s should no longer be watched", ref)
+	}
+	collectMsgs(cmd)
+
+	reloaded, _ = config.NewWithDir(tempDir)
+	if got := reloaded.Settings().WatchedOrders; len(got) != 0 {
+		t.Errorf("WatchedOrders = %v, want none", got)
+	}
+}
+
+func TestMergeWatched(t *testing.T) {
+	order := func(ref, status string) model.CombinedOrder {
+		return model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: ref, OrderStatus: status}}
+	}
+	current := []model.CombinedOrder{order("RN1", "BOOKED"), order("RN2", "BOOKED")}
+	fetched := []model.CombinedOrder{order("RN1", "IN_TRANSIT"), order("RN2", "IN_TRANSIT"), order("RN3", "BOOKED")}
+
+	merged := mergeWatched(current, fetched, map[string]bool{"RN2": true})
+
+	want := map[string]string{
+		"RN1": "BOOKED",     // not watched: keeps its current data
+		"RN2": "IN_TRANSIT", // watched: updated
+		"RN3": "BOOKED",     // new order: taken from the API
+	}
+	if len(merged) != len(want) {
+		t.Fatalf("mergeWatched() returned %d orders, want %d", len(merged), len(want))
+	}
+	for _, o := range merged {
+		if o.Order.OrderStatus != want[o.Order.ReferenceNumber] {
+			t.Errorf("%s status = %s, want %s", o.Order.ReferenceNumber, o.Order.OrderStatus, want[o.Order.ReferenceNumber])
+		}
+	}
+}
+
+func TestAutoRefresh_OnlyUpdatesWatchedOrders(t *testing.T) {
+	current := []model.CombinedOrder{
+		{Order: model.TeslaOrder{ReferenceNumber: "RN000000001", OrderStatus: "BOOKED"}},
+		{Order: model.TeslaOrder{ReferenceNumber: "RN000000002", OrderStatus: "BOOKED"}},
+	}
+	fetched := []model.CombinedOrder{
+		{Order: model.TeslaOrder{ReferenceNumber: "RN000000001", OrderStatus: "DELIVERED"}},
+		{Order: model.TeslaOrder{ReferenceNumber: "RN000000002", OrderStatus: "DELIVERED"}},
+	}
+
+	client := api.NewMockClient(fetched)
+	m := newTestModel(t, client)
+	m.view = ViewOrders
+	m.tokens = &model.TeslaTokens{AccessToken: "token"}
+	m.orders = current
+	watchedRef := current[1].Order.ReferenceNumber
+	m.watched = map[string]bool{watchedRef: true}
+
+	msg, ok := m.loadWatchedOrders().(OrdersLoadedMsg)
+	if !ok || msg.Error != nil {
+		t.Fatalf("loadWatchedOrders() = %#v, want OrdersLoadedMsg", msg)
+	}
+	if client.CallCount("GetAllOrderData") != 0 || client.CallCount("GetOrderDataFor") != 1 {
+		t.Errorf("calls = %v, want only GetOrderDataFor", client.Calls())
+	}
+	if len(msg.Orders) != 2 {
+		t.Fatalf("loadWatchedOrders() returned %d orders, want 2", len(msg.Orders))
+	}
+	for _, o := range msg.Orders {
+		watched := o.Order.ReferenceNumber == watchedRef
+		if watched && o.Order.OrderStatus != "DELIVERED" {
+			t.Errorf("watched order %s was not updated", o.Order.ReferenceNumber)
+		}
+		if !watched && o.Order.OrderStatus == "DELIVERED" {
+			t.Errorf("unwatched order %s was updated", o.Order.ReferenceNumber)
+		}
+	}
+}
+
+func TestTokenWatch_LogsOutWhenTokensDeleted(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-tokens-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+	if cfg.IsKeyringAvailable() {
+		t.Skip("tokens are stored in the keyring, not the tokens file")
+	}
+	tokens := &model.TeslaTokens{AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := cfg.SaveTokens(tokens); err != nil {
+		t.Fatalf("SaveTokens() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.config = cfg
+	m.tokens = tokens
+	m.view = ViewOrders
+
+	logoutMsg := func(cmd tea.Cmd) (LogoutMsg, bool) {
+		for _, msg := range collectMsgs(cmd) {
+			if logout, ok := msg.(LogoutMsg); ok {
+				return logout, true
+			}
+		}
+		return LogoutMsg{}, false
+	}
+
+	_, cmd := m.Update(TokenWatchTickMsg(time.Now()))
+	if _, ok := logoutMsg(cmd); ok {
+		t.Fatal("no LogoutMsg expected while the tokens file exists")
+	}
+
+	if err := os.Remove(filepath.Join(tempDir, "tokens.enc")); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	_, cmd = m.Update(TokenWatchTickMsg(time.Now()))
+	logout, ok := logoutMsg(cmd)
+	if !ok {
+		t.Fatal("deleting the tokens file should produce a LogoutMsg")
+	}
+
+	updated, _ := m.Update(logout)
+	m = updated.(Model)
+	if m.view != ViewLogin {
+		t.Errorf("view = %v, want ViewLogin", m.view)
+	}
+	if m.toastMessage != "Session ended – please login again" {
+		t.Errorf("toast = %q, want the session ended notice", m.toastMessage)
+	}
+}
+
+func TestWithMinimal_NoBorders(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil)).WithMinimal()
+	m.width, m.height = 120, 40
+	m.viewport.Width, m.viewport.Height = 116, 28
+	m.orders = demo.GetDemoOrders()
+
+	views := map[string]func() string{}
+	views["orders"] = func() string { m.view = ViewOrders; return m.View() }
+	for _, tab := range []Tab{TabDetails, TabTasks, TabChecklist} {
+		tab := tab
+		views[fmt.Sprintf("detail tab %d", tab)] = func() string {
+			m.view = ViewDetail
+			m.selectedTab = tab
+			m.viewport.SetContent(m.getTabContent())
+			return m.View()
+		}
+	}
+	views["help"] = func() string { m.view = ViewHelp; return m.View() }
+	views["stats"] = func() string { m.view = ViewStats; return m.View() }
+	views["login"] = func() string { m.view = ViewLogin; return m.View() }
+
+	for name, render := range views {
+		view := render()
+		// Rounded corners only come from borders
+		if strings.ContainsAny(view, "╭╮╰╯") {
+			t.Errorf("%s view has border corners in minimal mode:\n%s", name, view)
+		}
+	}
+
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+	if view := m.View(); !strings.Contains(view, "[Details]") {
+		t.Error("minimal mode should render plain text tabs")
+	}
+	m.selectedTab = TabChecklist
+	m.viewport.SetContent(m.getTabContent())
+	if strings.Contains(m.View(), "░") {
+		t.Error("minimal mode should hide the checklist progress bar")
+	}
+}
+
+func TestWithMinimal_NoConfetti(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil)).WithMinimal()
+	m.view = ViewOrders
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: demo.GetDemoOrders()})
+	m = updated.(Model)
+
+	orders, diffs := deliveredOrders()
+	updated, _ = m.Update(OrdersLoadedMsg{Orders: orders, Diffs: diffs})
+	if got := updated.(Model); got.animationFrame != 0 {
+		t.Errorf("animationFrame = %d, want no confetti in minimal mode", got.animationFrame)
+	}
+}
+
+func TestView_MinTerminalSize(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 79, 24
+	if view := m.View(); !strings.Contains(view, "Terminal too small") || !strings.Contains(view, "Minimum: 80×24") {
+		t.Error("79×24 should be too small by default")
+	}
+
+	if view := m.WithMinTerminalSize(79, 0).View(); strings.Contains(view, "Terminal too small") {
+		t.Error("79×24 should fit with a minimum width of 79")
+	}
+
+	// Saved settings set the minimum too
+	cfg, err := config.NewWithDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+	settings := cfg.Settings()
+	settings.MinTerminalWidth = 60
+	if err := cfg.SaveSettings(settings); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+	m = New(cfg, api.NewMockClient(nil), m.history, m.checklist)
+	m.width, m.height = 60, 24
+	if view := m.View(); strings.Contains(view, "Terminal too small") {
+		t.Error("60×24 should fit with a saved minimum width of 60")
+	}
+	m.height = 23
+	if view := m.View(); !strings.Contains(view, "Minimum: 60×24") {
+		t.Error("the warning should show the saved minimum")
+	}
+}
+
+func TestWithDebug_LogsMessages(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	m := newTestModel(t, api.NewMockClient(nil)).WithDebug(logger)
+	m.view = ViewOrders
+
+	var model tea.Model = m
+	for _, msg := range []tea.Msg{
+		tea.WindowSizeMsg{Width: 120, Height: 40},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")},
+		ToastMsg{Message: "hello"},
+		OrdersLoadedMsg{Orders: demo.GetDemoOrders()},
+	} {
+		model, _ = model.Update(msg)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"msg=tea.WindowSizeMsg width=120 height=40",
+		"msg=tea.KeyMsg key=?",
+		"msg=tui.ToastMsg message=hello",
+		"msg=tui.OrdersLoadedMsg orders=1",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("debug log missing %q, got:\n%s", want, out)
+		}
+	}
+
+	// Logging must not change behavior
+	if model.(Model).view != ViewHelp {
+		t.Errorf("view = %v, want ViewHelp", model.(Model).view)
+	}
+}
+
+func TestChangeLogView_OpenAndClose(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+
+	now := time.Now()
+	snapshot := func(ts time.Time, ref, modelCode, status string, vin *string) model.HistoricalSnapshot {
+		return model.HistoricalSnapshot{Timestamp: ts, Data: model.CombinedOrder{
+			Order: model.TeslaOrder{ReferenceNumber: ref, ModelCode: modelCode, OrderStatus: status, VIN: vin},
+		}}
+	}
+	vin := "7SAYGDEE1PA000001"
+	for _, h := range []*model.OrderHistory{
+		{ReferenceNumber: "RN000000001", Snapshots: []model.HistoricalSnapshot{
+			snapshot(now.Add(-5*time.Hour), "RN000000001", "my", "BOOKED", nil),
+			snapshot(now.Add(-2*time.Hour), "RN000000001", "my", "BOOKED", &vin),
+		}},
+		{ReferenceNumber: "RN000000002", Snapshots: []model.HistoricalSnapshot{
+			snapshot(now.Add(-4*time.Hour), "RN000000002", "m3", "BOOKED", nil),
+			snapshot(now.Add(-3*time.Hour), "RN000000002", "m3", "IN_TRANSIT", nil),
+		}},
+	} {
+		if err := m.history.SaveHistory(h); err != nil {
+			t.Fatalf("SaveHistory() error = %v", err)
+		}
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	m = updated.(Model)
+	if m.view != ViewChangeLog {
+		t.Fatalf("view = %v, want ViewChangeLog", m.view)
+	}
+	if len(m.changeLog) != 2 {
+		t.Fatalf("change log has %d entries, want 2", len(m.changeLog))
+	}
+
+	view := m.View()
+	vinLine := "2 hours ago – Model Y: VIN assigned"
+	statusLine := "3 hours ago – Model 3: Order Status: BOOKED → IN_TRANSIT"
+	for _, want := range []string{"Change Log", vinLine, statusLine} {
+		if !strings.Contains(view, want) {
+			t.Errorf("change log view missing %q", want)
+		}
+	}
+	if strings.Index(view, vinLine) > strings.Index(view, statusLine) {
+		t.Error("change log is not newest first")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.view != ViewOrders {
+		t.Errorf("view = %v, want ViewOrders after esc", m.view)
+	}
+}
+
+func TestChangeLogView_Empty(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	m = updated.(Model)
+	if !strings.Contains(m.View(), "No changes recorded yet.") {
+		t.Error("empty change log should show a placeholder")
+	}
+}
+
+func TestDescribeChange(t *testing.T) {
+	tests := []struct {
+		diff model.OrderDiff
+		want string
+	}{
+		{model.OrderDiff{Field: "VIN", OldValue: "N/A", NewValue: "7SAYGDEE1PA000001"}, "VIN assigned"},
+		{model.OrderDiff{Field: "Delivery Appointment", OldValue: "", NewValue: "June 1"}, "Delivery Appointment assigned"},
+		{model.OrderDiff{Field: "License Plate", OldValue: "AB-123-C", NewValue: "N/A"}, "License Plate removed"},
+		{model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}, "Order Status: BOOKED → DELIVERED"},
+	}
+
+	for _, tt := range tests {
+		if got := describeChange(tt.diff); got != tt.want {
+			t.Errorf("describeChange(%+v) = %q, want %q", tt.diff, got, tt.want)
+		}
+	}
+}
+
+func TestAcknowledgeChanges_ClearsBadge(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	acks, err := storage.NewAcknowledgements(tempDir)
+	if err != nil {
+		t.Fatalf("NewAcknowledgements() error = %v", err)
+	}
+
+	orders := demo.GetDemoOrders()
+	ref := orders[0].Order.ReferenceNumber
+	diff := model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"}
+	if _, err := acks.Record(ref, []model.OrderDiff{diff}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(orders)).WithAcknowledgements(acks)
+	m.view = ViewOrders
+
+	// Pending changes survive a reload with no fresh diffs
+	for _, msg := range collectMsgs(m.loadOrders) {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	if _, ok := m.diffs[ref]; !ok {
+		t.Fatal("unacknowledged change should be shown after reload")
+	}
+
+	m.view = ViewDetail
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m = updated.(Model)
+	for _, msg := range collectMsgs(cmd) {
+		updated, _ = m.Update(msg)
+		m = updated.(Model)
+	}
+
+	if _, ok := m.diffs[ref]; ok {
+		t.Error("change badge should be cleared after acknowledging")
+	}
+	pending, err := acks.Unacknowledged(ref)
+	if err != nil {
+		t.Fatalf("Unacknowledged() error = %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("pending = %v, want none", pending)
+	}
+}
+
+func TestRenderCountdown_Timezones(t *testing.T) {
+	timeNow = func() time.Time { return time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	order := demo.GetDemoOrders()[0] // appointment June 15, 2026 at 10:00 AM
+
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{"US/Eastern", "14h 0m"},
+		{"Europe/Amsterdam", "8h 0m"},
+		{"Asia/Shanghai", "2h 0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			m := newTestModel(t, api.NewMockClient(nil))
+			m.location = loadLocation(tt.zone)
+			if m.location == nil {
+				t.Skipf("timezone data unavailable for %s", tt.zone)
+			}
+
+			got := m.renderCountdown(order)
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("renderCountdown() = %q, want countdown %q", got, tt.want)
+			}
+			if strings.Contains(got, "configure timezone") {
+				t.Error("renderCountdown() should not show the UTC hint when a timezone is set")
+			}
+		})
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		m := newTestModel(t, api.NewMockClient(nil))
+		got := m.renderCountdown(order)
+		if !strings.Contains(got, "10h 0m") {
+			t.Errorf("renderCountdown() = %q, want UTC countdown 10h 0m", got)
+		}
+		if !strings.Contains(got, "configure timezone in settings") {
+			t.Error("renderCountdown() should hint at configuring a timezone")
+		}
+	})
+}
+
+func TestClearToastAfterDelay_Duration(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	if m.toastDuration != defaultToastDuration {
+		t.Errorf("toastDuration = %v, want %v", m.toastDuration, defaultToastDuration)
+	}
+
+	m = m.WithToastDuration(100 * time.Millisecond)
+	start := time.Now()
+	msg := m.clearToastAfterDelay()()
+	if _, ok := msg.(ClearToastMsg); !ok {
+		t.Fatalf("clearToastAfterDelay() msg = %T, want ClearToastMsg", msg)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("100ms toast cleared after %v", elapsed)
+	}
+}
+
+func TestApplySettings_ToastDuration(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	settings := config.DefaultSettings()
+	settings.ToastDuration = 10 * time.Second
+	m.applySettings(settings)
+	if m.toastDuration != 10*time.Second {
+		t.Errorf("toastDuration = %v, want 10s", m.toastDuration)
+	}
+}
+
+func TestOrdersKeys_ExportAll(t *testing.T) {
+	dir, err := os.MkdirTemp("", "tesla-tui-export-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	t.Chdir(dir)
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.orders = demo.GetDemoOrders()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("E")})
+	if cmd == nil {
+		t.Fatal("'E' should return an export command")
+	}
+	toast, ok := cmd().(ToastMsg)
+	if !ok || toast.IsError {
+		t.Fatalf("export msg = %#v, want success toast", toast)
+	}
+
+	fileName := export.OrdersFileName()
+	if !strings.Contains(toast.Message, fileName) {
+		t.Errorf("toast = %q, want it to mention %s", toast.Message, fileName)
+	}
+	if _, err := os.Stat(filepath.Join(dir, fileName)); err != nil {
+		t.Errorf("export file not written: %v", err)
+	}
+}
+
+func TestHistoryTab_ExportCSV(t *testing.T) {
+	dir, err := os.MkdirTemp("", "tesla-tui-export-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	t.Chdir(dir)
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = demo.GetDemoOrders()
+	m.view = ViewDetail
+	m.selectedTab = TabHistory
+	order := m.orders[0]
+	changed := order
+	changed.Order.OrderStatus = "DELIVERED"
+	if err := m.history.SaveHistory(&model.OrderHistory{
+		ReferenceNumber: order.Order.ReferenceNumber,
+		Snapshots: []model.HistoricalSnapshot{
+			{Timestamp: time.Now().Add(-time.Hour), Data: order},
+			{Timestamp: time.Now(), Data: changed},
+		},
+	}); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("C")})
+	if cmd == nil {
+		t.Fatal("'C' should return an export command")
+	}
+	toast, ok := cmd().(ToastMsg)
+	if !ok || toast.IsError {
+		t.Fatalf("export msg = %#v, want success toast", toast)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, export.HistoryCSVFileName(order.Order.ReferenceNumber)))
+	if err != nil {
+		t.Fatalf("export file not written: %v", err)
+	}
+	if !strings.Contains(string(data), "Order Status,"+order.Order.OrderStatus+",DELIVERED") {
+		t.Errorf("exported CSV missing the status change:\n%s", data)
+	}
+}
+
+func TestOrdersKeys_ExportAllChecklists(t *testing.T) {
+	dir, err := os.MkdirTemp("", "tesla-tui-export-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	t.Chdir(dir)
+
+	cfg, err := config.NewWithDir(filepath.Join(dir, "config"))
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+	cl, err := storage.NewChecklist(cfg.ConfigDir())
+	if err != nil {
+		t.Fatalf("NewChecklist() error = %v", err)
+	}
+	hist, err := storage.NewHistory(cfg.ConfigDir())
+	if err != nil {
+		t.Fatalf("NewHistory() error = %v", err)
+	}
+
+	m := New(cfg, api.NewMockClient(nil), hist, cl)
+	m.view = ViewOrders
+	m.orders = demo.GetDemoOrders()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	if cmd == nil {
+		t.Fatal("'Z' should return an export command")
+	}
+	toast, ok := cmd().(ToastMsg)
+	if !ok || toast.IsError {
+		t.Fatalf("export msg = %#v, want success toast", toast)
+	}
+
+	fileName := export.ChecklistsZipFileName()
+	if !strings.Contains(toast.Message, fileName) {
+		t.Errorf("toast = %q, want it to mention %s", toast.Message, fileName)
+	}
+	if _, err := os.Stat(filepath.Join(dir, fileName)); err != nil {
+		t.Errorf("export file not written: %v", err)
+	}
+}
+
+func TestStartCountdown(t *testing.T) {
+	timeNow = func() time.Time { return time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	setup := func() Model {
+		m := newTestModel(t, api.NewMockClient(nil)).WithAutoRefresh(5 * time.Minute)
+		m.orders = demo.GetDemoOrders() // appointment June 15, 2026 at 10:00 AM
+		m.view = ViewDetail
+		return m
+	}
+
+	t.Run("detail view in watch mode", func(t *testing.T) {
+		m := setup()
+		if cmd := m.startCountdown(); cmd == nil {
+			t.Fatal("startCountdown() = nil, want tick command")
+		}
+		if m.countdownTick.IsZero() {
+			t.Error("countdownTick should be set once ticking")
+		}
+		if cmd := m.startCountdown(); cmd != nil {
+			t.Error("startCountdown() should not start a second ticker")
+		}
+	})
+
+	t.Run("without watch mode", func(t *testing.T) {
+		m := setup()
+		m.autoRefresh = false
+		if cmd := m.startCountdown(); cmd != nil {
+			t.Error("startCountdown() should not tick outside watch mode")
+		}
+	})
+
+	t.Run("orders view", func(t *testing.T) {
+		m := setup()
+		m.view = ViewOrders
+		if cmd := m.startCountdown(); cmd != nil {
+			t.Error("startCountdown() should not tick outside the detail view")
+		}
+	})
+
+	t.Run("appointment passed", func(t *testing.T) {
+		m := setup()
+		timeNow = func() time.Time { return time.Date(2026, 6, 16, 0, 0, 0, 0, time.UTC) }
+		if cmd := m.startCountdown(); cmd != nil {
+			t.Error("startCountdown() should not tick once the countdown reached zero")
+		}
+	})
+}
+
+func TestCountdownTickMsg(t *testing.T) {
+	timeNow = func() time.Time { return time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	m := newTestModel(t, api.NewMockClient(nil)).WithAutoRefresh(5 * time.Minute)
+	m.orders = demo.GetDemoOrders()
+	m.view = ViewDetail
+
+	tick := time.Date(2026, 6, 15, 0, 0, 30, 0, time.UTC)
+	updated, cmd := m.Update(CountdownTickMsg(tick))
+	m = updated.(Model)
+	if cmd == nil {
+		t.Error("tick in the detail view should schedule the next tick")
+	}
+	if !m.countdownTick.Equal(tick) {
+		t.Errorf("countdownTick = %v, want %v", m.countdownTick, tick)
+	}
+	if got := m.renderCountdown(m.orders[0]); !strings.Contains(got, "9h 59m 30s") {
+		t.Errorf("renderCountdown() = %q, want live countdown 9h 59m 30s", got)
+	}
+
+	m.view = ViewOrders
+	updated, cmd = m.Update(CountdownTickMsg(tick.Add(time.Second)))
+	m = updated.(Model)
+	if cmd != nil {
+		t.Error("tick after leaving the detail view should stop the ticker")
+	}
+	if !m.countdownTick.IsZero() {
+		t.Error("countdownTick should be reset when the ticker stops")
+	}
+}
+
+func TestDetailKeys_NumberJumpsToTab(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewDetail
+	m.orders = demo.GetDemoOrders()
+
+	for _, tt := range []struct {
+		key  string
+		want Tab
+	}{
+		{"5", TabJSON},
+		{"3", TabChecklist},
+		{"1", TabDetails},
+	} {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+		m = updated.(Model)
+		if m.selectedTab != tt.want {
+			t.Errorf("after %q selectedTab = %v, want %v", tt.key, m.selectedTab, tt.want)
+		}
+	}
+}
+
+func TestMapsURL(t *testing.T) {
+	tests := []struct {
+		goos    string
+		address string
+		want    string
+	}{
+		{"darwin", "Tesla Amsterdam, Cornelis Douwesweg 18", "maps://maps.apple.com/?address=Tesla+Amsterdam%2C+Cornelis+Douwesweg+18"},
+		{"linux", "Tesla Amsterdam, Cornelis Douwesweg 18", "https://maps.google.com/?q=Tesla+Amsterdam%2C+Cornelis+Douwesweg+18"},
+		{"linux", "München Freiham & Co", "https://maps.google.com/?q=M%C3%BCnchen+Freiham+%26+Co"},
+		{"", "Utrecht - Eendrachtlaan", "https://maps.google.com/?q=Utrecht+-+Eendrachtlaan"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos+"/"+tt.address, func(t *testing.T) {
+			if got := mapsURL(tt.goos, tt.address); got != tt.want {
+				t.Errorf("mapsURL(%q, %q) = %q, want %q", tt.goos, tt.address, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetailKeys_GoogleMapsWithoutDeliveryAddress(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = []model.CombinedOrder{{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}}
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+
+	updated, _ := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	got := updated.(Model)
+	if !got.toastIsError || got.toastMessage != "No delivery address available" {
+		t.Errorf("toast = %q (error %v), want the missing delivery address error", got.toastMessage, got.toastIsError)
+	}
+}
+
+func TestDeliveryAddress(t *testing.T) {
+	order := demo.GetDemoOrders()[0]
+	if got := deliveryAddress(order); got == "" || got == "N/A" {
+		t.Errorf("deliveryAddress() = %q, want the appointment address", got)
+	}
+
+	if got := deliveryAddress(model.CombinedOrder{}); got != "N/A" {
+		t.Errorf("deliveryAddress() = %q, want N/A without scheduling data", got)
+	}
+}
+
+func TestViewOrders_VisibleColumns(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	m.columns, _ = resolveColumns([]string{"Model", "Ready Score"})
+
+	view := m.View()
+	if !strings.Contains(view, "Ready Score") {
+		t.Error("orders view should show the Ready Score column")
+	}
+	if strings.Contains(view, "Delivery Window") {
+		t.Error("orders view should hide the Delivery Window column")
+	}
+}
+
+func TestLoadOrders_ReportsProgress(t *testing.T) {
+	base := demo.GetDemoOrders()[0]
+	orders := make([]model.CombinedOrder, 3)
+	for i := range orders {
+		orders[i] = base
+		orders[i].Order.ReferenceNumber = fmt.Sprintf("RN00000000%d", i+1)
+	}
+
+	m := newTestModel(t, api.NewMockClient(orders))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.loading = true
+
+	if msg := m.loadOrders(); msg.(OrdersLoadedMsg).Error != nil {
+		t.Fatalf("loadOrders() error = %v", msg.(OrdersLoadedMsg).Error)
+	}
+
+	for i := 1; i <= 3; i++ {
+		msg := m.waitForProgress()()
+		progress, ok := msg.(ProgressMsg)
+		if !ok {
+			t.Fatalf("waitForProgress() = %T, want ProgressMsg", msg)
+		}
+		if progress.Current != i || progress.Total != 3 {
+			t.Errorf("progress #%d = %+v, want {%d 3}", i, progress, i)
+		}
+
+		updated, cmd := m.Update(progress)
+		m = updated.(Model)
+		if cmd == nil {
+			t.Error("ProgressMsg should re-arm the progress listener")
+		}
+		if want := fmt.Sprintf("Loading details %d/3", i); !strings.Contains(m.View(), want) {
+			t.Errorf("view missing %q", want)
+		}
+		if got, want := m.progressBar.Percent(), float64(i)/3; got != want {
+			t.Errorf("progress bar percent = %v, want %v", got, want)
+		}
+	}
+
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: orders})
+	if got := updated.(Model).progressBar.Percent(); got != 0 {
+		t.Errorf("progress bar percent after load = %v, want 0", got)
+	}
+}
+
+func TestRenderSnapshotDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		diffs   []model.OrderDiff
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "no diffs",
+			diffs:   nil,
+			notWant: []string{"Order", "Delivery", "Payment"},
+		},
+		{
+			name:    "order metadata",
+			diffs:   []model.OrderDiff{{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}},
+			want:    []string{"📋 Order", "Order Status: BOOKED → DELIVERED"},
+			notWant: []string{"🚗", "💳"},
+		},
+		{
+			name:    "delivery info",
+			diffs:   []model.OrderDiff{{Field: "Delivery Window", OldValue: "Apr - May 2026", NewValue: "May - Jun 2026"}},
+			want:    []string{"🚗 Delivery", "Delivery Window: Apr - May 2026 → May - Jun 2026"},
+			notWant: []string{"📋", "💳"},
+		},
+		{
+			name:    "payment",
+			diffs:   []model.OrderDiff{{Field: "Amount Due", OldValue: "40000 EUR", NewValue: "39120 EUR"}},
+			want:    []string{"💳 Payment", "Amount Due: 40000 EUR → 39120 EUR"},
+			notWant: []string{"📋", "🚗"},
+		},
+		{
+			name:  "unknown field falls under order",
+			diffs: []model.OrderDiff{{Field: "Something New", OldValue: "a", NewValue: "b"}},
+			want:  []string{"📋 Order", "Something New: a → b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderSnapshotDiff(tt.diffs)
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("renderSnapshotDiff() missing %q in:\n%s", w, got)
+				}
+			}
+			for _, nw := range tt.notWant {
+				if strings.Contains(got, nw) {
+					t.Errorf("renderSnapshotDiff() unexpectedly contains %q in:\n%s", nw, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderSnapshotDiff_CategoryOrder(t *testing.T) {
+	got := renderSnapshotDiff([]model.OrderDiff{
+		{Field: "Amount Due", OldValue: "1", NewValue: "2"},
+		{Field: "Delivery Center", OldValue: "A", NewValue: "B"},
+		{Field: "VIN", OldValue: "N/A", NewValue: "XP7"},
+	})
+
+	order := strings.Index(got, "Order")
+	delivery := strings.Index(got, "🚗 Delivery")
+	payment := strings.Index(got, "Payment")
+	if !(order < delivery && delivery < payment) {
+		t.Errorf("categories out of order: order=%d delivery=%d payment=%d", order, delivery, payment)
+	}
+}
+
+func TestLoadOrders_OfflineFallsBackToHistory(t *testing.T) {
+	order := demo.GetDemoOrders()[0]
+
+	client := api.NewMockClient(nil)
+	client.Err = fmt.Errorf("request failed: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")})
+	m := newTestModel(t, client)
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+
+	if _, err := m.history.AddSnapshot(order); err != nil {
+		t.Fatalf("AddSnapshot() error = %v", err)
+	}
+
+	msg, ok := m.loadOrders().(OrdersLoadedMsg)
+	if !ok {
+		t.Fatal("loadOrders() did not return OrdersLoadedMsg")
+	}
+	if msg.Error != nil {
+		t.Fatalf("loadOrders() error = %v, want cached fallback", msg.Error)
+	}
+	if !msg.Offline {
+		t.Error("OrdersLoadedMsg.Offline = false, want true")
+	}
+	if len(msg.Orders) != 1 || msg.Orders[0].Order.ReferenceNumber != order.Order.ReferenceNumber {
+		t.Fatalf("cached orders = %+v, want the stored snapshot", msg.Orders)
+	}
+
+	updated, _ := m.Update(msg)
+	m = updated.(Model)
+	if !m.offline {
+		t.Error("Model.offline = false after offline load")
+	}
+	if !strings.Contains(m.viewOrders(), "Offline – showing cached data") {
+		t.Error("orders view should show the offline banner")
+	}
+
+	// Details work from the cached snapshot
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+	if !strings.Contains(m.renderDetailsTab(m.orders[0], nil), order.Order.GetVIN()) {
+		t.Error("Details tab should render cached order data")
+	}
+}
+
+func TestLoadOrders_OfflineWithoutHistory(t *testing.T) {
+	client := api.NewMockClient(nil)
+	client.Err = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	m := newTestModel(t, client)
+
+	msg := m.loadOrders().(OrdersLoadedMsg)
+	if msg.Error == nil {
+		t.Error("loadOrders() should return the network error when no history exists")
+	}
+	if msg.Offline {
+		t.Error("OrdersLoadedMsg.Offline = true without cached data")
+	}
+}
+
+func TestLoadOrders_APIErrorDoesNotUseHistory(t *testing.T) {
+	order := demo.GetDemoOrders()[0]
+
+	client := api.NewMockClient(nil)
+	client.Err = &api.APIError{StatusCode: 500, Body: "boom"}
+	m := newTestModel(t, client)
+	if _, err := m.history.AddSnapshot(order); err != nil {
+		t.Fatalf("AddSnapshot() error = %v", err)
+	}
+
+	msg := m.loadOrders().(OrdersLoadedMsg)
+	if msg.Error == nil || msg.Offline {
+		t.Errorf("loadOrders() = %+v, want API error without offline fallback", msg)
+	}
+}
+
+func historyTestModel(t *testing.T) (Model, *model.OrderHistory) {
+	t.Helper()
+
+	order := demo.GetDemoOrders()[0]
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabHistory
+	m.width, m.height = 120, 40
+	m.viewport.Width, m.viewport.Height = 100, 10
+
+	// Snapshots 1 and 3 change something; 2 is identical to 1
+	statuses := []string{"RESERVED", "BOOKED", "BOOKED", "DELIVERED"}
+	history := &model.OrderHistory{ReferenceNumber: order.Order.ReferenceNumber}
+	for i, status := range statuses {
+		data := order
+		data.Order.OrderStatus = status
+		history.Snapshots = append(history.Snapshots, model.HistoricalSnapshot{
+			Timestamp: time.Now().Add(time.Duration(i-len(statuses)) * time.Hour),
+			Data:      data,
+		})
+	}
+	if err := m.history.SaveHistory(history); err != nil {
+		t.Fatalf("SaveHistory() error = %v", err)
+	}
+	return m, history
+}
+
+func TestNextChangeIndex(t *testing.T) {
+	_, history := historyTestModel(t)
+
+	tests := []struct {
+		name    string
+		current int
+		forward bool
+		want    int
+		wantOK  bool
+	}{
+		{"first next focuses newest change", -1, true, 3, true},
+		{"next skips unchanged snapshot", 3, true, 1, true},
+		{"next past oldest change", 1, true, 1, false},
+		{"prev moves to newer change", 1, false, 3, true},
+		{"prev past newest change", 3, false, 3, false},
+		{"prev without focus", -1, false, -1, false},
+		{"stale index past the end", 10, true, 3, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nextChangeIndex(history, tt.current, tt.forward)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("nextChangeIndex(%d, %v) = %d, %v; want %d, %v", tt.current, tt.forward, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHistoryKeys_NavigateChanges(t *testing.T) {
+	m, _ := historyTestModel(t)
+
+	press := func(key string) {
+		updated, _ := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+
+	press("n")
+	if m.historyIndex != 3 {
+		t.Fatalf("historyIndex after n = %d, want 3", m.historyIndex)
+	}
+	press("n")
+	if m.historyIndex != 1 {
+		t.Fatalf("historyIndex after n n = %d, want 1", m.historyIndex)
+	}
+	if m.viewport.YOffset == 0 {
+		t.Error("viewport should scroll to the focused snapshot")
+	}
+	press("p")
+	if m.historyIndex != 3 {
+		t.Errorf("historyIndex after p = %d, want 3", m.historyIndex)
+	}
+
+	// n/p do nothing outside the History tab
+	m.selectedTab = TabDetails
+	press("n")
+	if m.historyIndex != 3 {
+		t.Errorf("historyIndex changed on Details tab: %d", m.historyIndex)
+	}
+}
+
+func TestRenderHistory_FocusedSnapshot(t *testing.T) {
+	m, _ := historyTestModel(t)
+
+	plain, line := m.renderHistory(m.orders[0])
+	if line != 0 {
+		t.Errorf("focus line without focus = %d, want 0", line)
+	}
+
+	m.historyIndex = 1
+	focused, line := m.renderHistory(m.orders[0])
+	if line == 0 {
+		t.Error("focus line should point at the focused snapshot")
+	}
+	if !strings.Contains(focused, "╭") || strings.Contains(plain, "╭") {
+		t.Error("focused snapshot should be outlined")
+	}
+	if lines := strings.Split(focused, "\n"); !strings.Contains(lines[line], "╭") {
+		t.Errorf("line %d = %q, want the focus border", line, lines[line])
+	}
+}
+
+func TestHistoryKeys_NoViewportConflict(t *testing.T) {
+	vpKeys := viewport.DefaultKeyMap()
+	for _, b := range []key.Binding{vpKeys.PageDown, vpKeys.PageUp, vpKeys.HalfPageUp, vpKeys.HalfPageDown, vpKeys.Up, vpKeys.Down, vpKeys.Left, vpKeys.Right} {
+		for _, k := range b.Keys() {
+			if k == "n" || k == "p" {
+				t.Errorf("viewport key %q conflicts with history navigation", k)
+			}
+		}
+	}
+}
+
+func TestClipboardCommand_Linux(t *testing.T) {
+	tests := []struct {
+		name      string
+		installed []string
+		wantArgs  []string
+	}{
+		{"xclip", []string{"xclip", "xsel", "wl-copy"}, []string{"xclip", "-selection", "clipboard"}},
+		{"no xclip", []string{"xsel", "wl-copy"}, []string{"xsel", "--clipboard", "--input"}},
+		{"no xclip or xsel", []string{"wl-copy"}, []string{"wl-copy"}},
+		{"no tools", nil, nil},
+	}
+
+	orig := lookPath
+	defer func() { lookPath = orig }()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookPath = func(file string) (string, error) {
+				for _, tool := range tt.installed {
+					if tool == file {
+						return file, nil
+					}
+				}
+				return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+			}
+
+			cmd, err := clipboardCommand("linux")
+			if tt.wantArgs == nil {
+				if !errors.Is(err, errNoClipboardTool) {
+					t.Errorf("clipboardCommand() error = %v, want errNoClipboardTool", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("clipboardCommand() error = %v", err)
+			}
+			if strings.Join(cmd.Args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("clipboardCommand() args = %v, want %v", cmd.Args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestUpdate_ClipboardMsgNoTool(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+
+	updated, _ := m.Update(ClipboardMsg{Error: errNoClipboardTool})
+	got := updated.(Model)
+	if got.toastMessage != "✗ No clipboard tool found (install xclip, xsel, or wl-clipboard)" || !got.toastIsError {
+		t.Errorf("toast = %q (error %v), want the install hint", got.toastMessage, got.toastIsError)
+	}
+
+	updated, _ = m.Update(ClipboardMsg{Error: errors.New("exit status 1")})
+	if got := updated.(Model); got.toastMessage != "✗ Failed to copy to clipboard" {
+		t.Errorf("toast = %q, want the generic failure", got.toastMessage)
+	}
+}
+
+func TestDetailKeys_CopyICS(t *testing.T) {
+	var copied string
+	orig := copyToClipboard
+	copyToClipboard = func(text string) tea.Cmd {
+		return func() tea.Msg {
+			copied = text
+			return ClipboardMsg{Text: text, Success: true}
+		}
+	}
+	defer func() { copyToClipboard = orig }()
+
+	order := demo.GetDemoOrders()[0]
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+
+	_, cmd := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	if cmd == nil {
+		t.Fatal("'I' should return a command")
+	}
+	msg, ok := cmd().(ToastMsg)
+	if !ok {
+		t.Fatal("'I' command should produce a ToastMsg")
+	}
+	if msg.Message != "✓ ICS copied to clipboard" || msg.IsError {
+		t.Errorf("toast = %+v, want ICS copied", msg)
+	}
+
+	want, err := export.GenerateICS(order, nil)
+	if err != nil {
+		t.Fatalf("GenerateICS() error = %v", err)
+	}
+	// DTSTAMP may differ by a second; compare the event body without it
+	stripStamp := func(s string) string {
+		var kept []string
+		for _, line := range strings.Split(s, "\r\n") {
+			if !strings.HasPrefix(line, "DTSTAMP:") {
+				kept = append(kept, line)
+			}
+		}
+		return strings.Join(kept, "\r\n")
+	}
+	if stripStamp(copied) != stripStamp(want) {
+		t.Errorf("copied ICS = %q, want %q", copied, want)
+	}
+}
+
+func TestDetailKeys_CopyICS_NoAppointment(t *testing.T) {
+	called := false
+	orig := copyToClipboard
+	copyToClipboard = func(text string) tea.Cmd {
+		called = true
+		return nil
+	}
+	defer func() { copyToClipboard = orig }()
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = []model.CombinedOrder{{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}}
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+
+	_, cmd := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	msg, ok := cmd().(ToastMsg)
+	if !ok || !msg.IsError {
+		t.Errorf("expected an error toast, got %+v", msg)
+	}
+	if called {
+		t.Error("clipboard should not be used without an appointment")
+	}
+}
+
+func TestDetailsTab_UsesTimelineFromLoad(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil)).WithDemoMode()
+	m.width, m.height = 120, 40
+	updated, _ := m.Update(DemoLoadedMsg{
+		Orders:  demo.GetDemoOrders(),
+		Diffs:   demo.GetDemoDiffs(),
+		History: demo.GetDemoHistory(),
+	})
+	m = updated.(Model)
+
+	order := m.orders[0]
+	if !strings.Contains(m.renderDetailsTab(order, nil), "after order") {
+		t.Fatal("Details tab should show stage timings from the order history")
+	}
+
+	// Rendering uses the timeline built at load time, not the history
+	m.demoHistory = nil
+	if !strings.Contains(m.renderDetailsTab(order, nil), "after order") {
+		t.Error("Details tab should not need the history once orders are loaded")
+	}
+}
+
+func TestFormatStageTiming(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{5 * time.Hour, "same day as order"},
+		{30 * time.Hour, "1 day after order"},
+		{14 * 24 * time.Hour, "14 days after order"},
+	}
+
+	for _, tt := range tests {
+		if got := formatStageTiming(tt.d); got != tt.want {
+			t.Errorf("formatStageTiming(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestArchiveToggle_HidesOrder(t *testing.T) {
+	base := demo.GetDemoOrders()[0]
+	orders := make([]model.CombinedOrder, 2)
+	for i := range orders {
+		orders[i] = base
+		orders[i].Order.ReferenceNumber = fmt.Sprintf("RN00000000%d", i+1)
+	}
+
+	m := newTestModel(t, api.NewMockClient(orders))
+	archiveDir, err := os.MkdirTemp("", "tesla-tui-archive-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(archiveDir) })
+	archive, err := storage.NewArchive(archiveDir)
+	if err != nil {
+		t.Fatalf("NewArchive() error = %v", err)
+	}
+	m = m.WithArchive(archive, false)
+	m.view = ViewOrders
+	m.orders = orders
+	m.selectedOrder = 1
+
+	_, cmd := m.handleOrdersKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	if cmd == nil {
+		t.Fatal("'A' should return a command")
+	}
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+
+	if len(m.orders) != 1 || m.orders[0].Order.ReferenceNumber != "RN000000001" {
+		t.Fatalf("orders after archive = %d, want only RN000000001", len(m.orders))
+	}
+	if m.selectedOrder != 0 {
+		t.Errorf("selectedOrder = %d, want 0", m.selectedOrder)
+	}
+	if ok, _ := archive.IsArchived("RN000000002"); !ok {
+		t.Error("archive should persist the archived order")
+	}
+
+	// Reloading keeps the archived order hidden
+	msg := m.loadOrders().(OrdersLoadedMsg)
+	if len(msg.Orders) != 1 {
+		t.Errorf("loadOrders() returned %d orders, want 1", len(msg.Orders))
+	}
+
+	// --show-archived includes it again, and 'A' unarchives
+	m = m.WithArchive(archive, true)
+	msg = m.loadOrders().(OrdersLoadedMsg)
+	if len(msg.Orders) != 2 {
+		t.Fatalf("loadOrders() with show-archived returned %d orders, want 2", len(msg.Orders))
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+	m.selectedOrder = 1
+	_, cmd = m.handleOrdersKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	toggled := cmd().(ArchiveToggledMsg)
+	if toggled.Archived {
+		t.Error("second toggle should unarchive the order")
+	}
+	if ok, _ := archive.IsArchived("RN000000002"); ok {
+		t.Error("archive should persist the unarchived order")
+	}
+}
+
+func TestSortPinned(t *testing.T) {
+	refs := func(orders []model.CombinedOrder) string {
+		var out []string
+		for _, o := range orders {
+			out = append(out, o.Order.ReferenceNumber)
+		}
+		return strings.Join(out, ",")
+	}
+	orders := func(refs ...string) []model.CombinedOrder {
+		var out []model.CombinedOrder
+		for _, ref := range refs {
+			out = append(out, model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: ref}})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		pinned map[string]bool
+		want   string
+	}{
+		{"no pins", nil, "A,B,C,D"},
+		{"pinned first", map[string]bool{"C": true}, "C,A,B,D"},
+		{"pinned keep relative order", map[string]bool{"D": true, "B": true}, "B,D,A,C"},
+		{"all pinned", map[string]bool{"A": true, "B": true, "C": true, "D": true}, "A,B,C,D"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := orders("A", "B", "C", "D")
+			got := refs(sortPinned(input, tt.pinned))
+			if got != tt.want {
+				t.Errorf("sortPinned() = %s, want %s", got, tt.want)
+			}
+			if refs(input) != "A,B,C,D" {
+				t.Error("sortPinned() must not modify its input")
+			}
+		})
+	}
+}
+
+// mixedDemoOrders returns the orders of every demo scenario: several models
+// and statuses, with and without delivery appointments
+func mixedDemoOrders(t *testing.T) []model.CombinedOrder {
+	t.Helper()
+	var orders []model.CombinedOrder
+	for _, name := range demo.Scenarios {
+		scenario, _, _, err := demo.GetDemoScenario(name)
+		if err != nil {
+			t.Fatalf("GetDemoScenario(%q) error = %v", name, err)
+		}
+		orders = append(orders, scenario...)
+	}
+	return orders
+}
+
+func TestSortOrders(t *testing.T) {
+	refs := func(orders []model.CombinedOrder) string {
+		var out []string
+		for _, o := range orders {
+			out = append(out, o.Order.ReferenceNumber)
+		}
+		return strings.Join(out, ",")
+	}
+	input := mixedDemoOrders(t)
+	loaded := refs(input)
+
+	tests := []struct {
+		mode int
+		want string
+	}{
+		{sortDefault, loaded},
+		// Appointments first (May 4, June 15, June 22), then by delivery window
+		{sortDeliveryDate, "RN400000005,RN123456789,RN500000006,RN200000003,RN500000007,RN300000004"},
+		// Cybertruck, Model 3, Model S, Model X, Model Y
+		{sortModel, "RN300000004,RN200000003,RN400000005,RN500000006,RN500000007,RN123456789"},
+		// BOOKED orders keep their loaded order ahead of DELIVERED
+		{sortStatus, "RN123456789,RN200000003,RN300000004,RN500000006,RN500000007,RN400000005"},
+		{sortReference, "RN123456789,RN200000003,RN300000004,RN400000005,RN500000006,RN500000007"},
+	}
+
+	for _, tt := range tests {
+		t.Run(sortModeNames[tt.mode], func(t *testing.T) {
+			if got := refs(sortOrders(input, tt.mode)); got != tt.want {
+				t.Errorf("sortOrders() = %s, want %s", got, tt.want)
+			}
+			if refs(input) != loaded {
+				t.Error("sortOrders() must not modify its input")
+			}
+		})
+	}
+}
+
+func TestOrdersKeys_SortCycles(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.view = ViewOrders
+	orders := mixedDemoOrders(t)
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: orders})
+	m = updated.(Model)
+	m.selectedOrder = 3 // RN400000005, the delivered Model S
+
+	press := func() {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+		m = updated.(Model)
+	}
+
+	press()
+	if m.sortMode != sortDeliveryDate {
+		t.Fatalf("sortMode = %d, want sortDeliveryDate", m.sortMode)
+	}
+	if got := m.orders[m.selectedOrder].Order.ReferenceNumber; got != "RN400000005" || m.selectedOrder != 0 {
+		t.Errorf("selected %s at %d, want RN400000005 at 0", got, m.selectedOrder)
+	}
+	if !strings.Contains(m.View(), "s: sort (delivery date)") {
+		t.Error("footer should show the current sort mode")
+	}
+
+	// Refreshing keeps the chosen sort
+	updated, _ = m.Update(OrdersLoadedMsg{Orders: orders})
+	m = updated.(Model)
+	if got := m.orders[0].Order.ReferenceNumber; got != "RN400000005" {
+		t.Errorf("first order after refresh = %s, want RN400000005", got)
+	}
+
+	// Cycling all the way around restores the loaded order
+	for range len(sortModeNames) - 1 {
+		press()
+	}
+	if m.sortMode != sortDefault {
+		t.Errorf("sortMode = %d, want sortDefault after a full cycle", m.sortMode)
+	}
+	for i, order := range m.orders {
+		if order.Order.ReferenceNumber != orders[i].Order.ReferenceNumber {
+			t.Fatalf("orders[%d] = %s, want loaded order restored", i, order.Order.ReferenceNumber)
+		}
+	}
+}
+
+func TestPinToggle_MovesOrderToTop(t *testing.T) {
+	base := demo.GetDemoOrders()[0]
+	orders := make([]model.CombinedOrder, 3)
+	for i := range orders {
+		orders[i] = base
+		orders[i].Order.ReferenceNumber = fmt.Sprintf("RN00000000%d", i+1)
+	}
+
+	pinsDir, err := os.MkdirTemp("", "tesla-tui-pins-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(pinsDir) })
+	pins, err := storage.NewPins(pinsDir)
+	if err != nil {
+		t.Fatalf("NewPins() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(orders)).WithPins(pins)
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+	m.loadedOrders = orders
+	m.orders = orders
+	m.selectedOrder = 2
+
+	_, cmd := m.handleOrdersKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+
+	if m.orders[0].Order.ReferenceNumber != "RN000000003" {
+		t.Errorf("first order = %s, want pinned RN000000003", m.orders[0].Order.ReferenceNumber)
+	}
+	if m.selectedOrder != 0 {
+		t.Errorf("selectedOrder = %d, want 0 (follows the pinned order)", m.selectedOrder)
+	}
+	if !strings.Contains(m.viewOrders(), "📌") {
+		t.Error("orders view should show the pin icon")
+	}
+
+	// Pins persist and apply to freshly loaded orders
+	reloaded := newTestModel(t, api.NewMockClient(orders)).WithPins(pins)
+	updated, _ = reloaded.Update(OrdersLoadedMsg{Orders: orders})
+	reloaded = updated.(Model)
+	if reloaded.orders[0].Order.ReferenceNumber != "RN000000003" {
+		t.Errorf("after reload first order = %s, want RN000000003", reloaded.orders[0].Order.ReferenceNumber)
+	}
+
+	// Unpinning puts the order back in its sorted position
+	_, cmd = m.handleOrdersKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.orders[2].Order.ReferenceNumber != "RN000000003" {
+		t.Errorf("after unpin last order = %s, want RN000000003", m.orders[2].Order.ReferenceNumber)
+	}
+	if m.selectedOrder != 2 {
+		t.Errorf("selectedOrder = %d, want 2 (follows the unpinned order)", m.selectedOrder)
+	}
+}
+
+func TestFindJSONMatches(t *testing.T) {
+	content := "{\n  \"vin\": \"XP7YACEF5TB123456\",\n  \"model\": \"my\",\n  \"Location\": \"Tilburg\"\n}"
+
+	tests := []struct {
+		name  string
+		query string
+		want  []int
+	}{
+		{"case insensitive", "LOCATION", []int{3}},
+		{"multiple lines", "\"", []int{1, 2, 3}},
+		{"no match", "cybertruck", nil},
+		{"last line", "}", []int{4}},
+		{"empty query", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findJSONMatches(content, tt.query)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("findJSONMatches(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightSearchMatches(t *testing.T) {
+	got := highlightSearchMatches(`"model": "Model Y"`, "model")
+	if strings.Count(got, SearchMatchStyle.Render("model")) != 1 || strings.Count(got, SearchMatchStyle.Render("Model")) != 1 {
+		t.Errorf("highlightSearchMatches() = %q, want both matches highlighted with their original case", got)
+	}
+}
+
+func TestJSONSearch_Keys(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.orders = demo.GetDemoOrders()
+	m.view = ViewDetail
+	m.selectedTab = TabJSON
+	m.viewport.Width, m.viewport.Height = 100, 5
+	m.viewport.SetContent(m.getTabContent())
+
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			updated, _ := m.Update(k)
+			m = updated.(Model)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("/"))
+	if !m.jsonSearching {
+		t.Fatal("'/' should open the search bar")
+	}
+
+	press(runes("c"), runes("o"), runes("m"), runes("p"), runes("l"), runes("e"), runes("t"), runes("e"))
+	if m.jsonSearchQuery != "complete" {
+		t.Errorf("jsonSearchQuery = %q, want %q", m.jsonSearchQuery, "complete")
+	}
+	if len(m.jsonSearchMatches) < 2 {
+		t.Fatalf("jsonSearchMatches = %v, want several matches", m.jsonSearchMatches)
+	}
+	text, _ := jsonTabText(m.orders[0])
+	if want := findJSONMatches(text, "complete"); fmt.Sprint(m.jsonSearchMatches) != fmt.Sprint(want) {
+		t.Errorf("jsonSearchMatches = %v, want %v", m.jsonSearchMatches, want)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.jsonSearching {
+		t.Error("enter should close the search bar")
+	}
+
+	press(runes("n"))
+	if m.jsonSearchIndex != 1 {
+		t.Errorf("jsonSearchIndex after n = %d, want 1", m.jsonSearchIndex)
+	}
+	line := m.jsonSearchMatches[1]
+	if line < m.viewport.YOffset || line >= m.viewport.YOffset+m.viewport.Height {
+		t.Errorf("match on line %d not in view (offset %d)", line, m.viewport.YOffset)
+	}
+
+	press(runes("N"), runes("N"))
+	if want := len(m.jsonSearchMatches) - 1; m.jsonSearchIndex != want {
+		t.Errorf("jsonSearchIndex after N N = %d, want %d (wrapped)", m.jsonSearchIndex, want)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.jsonSearchQuery != "" || m.jsonSearchMatches != nil {
+		t.Error("esc should clear the search")
+	}
+	if m.view != ViewDetail {
+		t.Error("esc with an active search should not leave the detail view")
+	}
+}
+
+func TestJSONTab_RawViewToggle(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := demo.GetDemoOrders()[0]
+	order.Details.RawJSON = map[string]interface{}{"unmergedField": "from the API"}
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabJSON
+	m.viewport.Width, m.viewport.Height = 100, 30
+	m.viewport.SetContent(m.getTabContent())
+
+	press := func() {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+		m = updated.(Model)
+	}
+
+	combined := m.getTabContent()
+	if !strings.Contains(combined, `"order"`) {
+		t.Fatal("combined view should include the order")
+	}
+
+	press()
+	if !m.rawViewMode {
+		t.Fatal("'v' should switch to raw view mode")
+	}
+	raw := m.getTabContent()
+	if strings.Contains(raw, `"order"`) || !strings.Contains(raw, "unmergedField") {
+		t.Errorf("raw view should show only the API response, got:\n%s", raw)
+	}
+	if !strings.Contains(m.renderTabs(), "JSON (raw)") {
+		t.Error("tab header should read \"JSON (raw)\" in raw view mode")
+	}
+
+	press()
+	if m.rawViewMode {
+		t.Fatal("'v' again should switch back to the combined view")
+	}
+	if m.getTabContent() != combined {
+		t.Error("toggling back should restore the combined view")
+	}
+	if strings.Contains(m.renderTabs(), "JSON (raw)") {
+		t.Error("tab header should read \"JSON\" in the combined view")
+	}
+}
+
+func TestJSONTab_RawViewWithoutResponse(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	order := demo.GetDemoOrders()[0]
+	order.Details.RawJSON = nil
+	m.orders = []model.CombinedOrder{order}
+	m.selectedTab = TabJSON
+	m.rawViewMode = true
+
+	if !strings.Contains(m.getTabContent(), "No raw API response available") {
+		t.Error("raw view should explain when there is no API response")
+	}
+}
+
+func TestJSONTab_HorizontalScroll(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	order := demo.GetDemoOrders()[0]
+	order.Details.RawJSON = map[string]interface{}{"blob": strings.Repeat("A", 200)}
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabJSON
+	m.viewport.Width, m.viewport.Height = 60, 10
+	m.viewport.SetContent(m.getTabContent())
+
+	press := func(k string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+		m = updated.(Model)
+	}
+
+	press("h")
+	if m.jsonScrollX != 0 {
+		t.Errorf("jsonScrollX = %d, want 0 at the left edge", m.jsonScrollX)
+	}
+
+	press("l")
+	if m.jsonScrollX != jsonScrollStep {
+		t.Errorf("jsonScrollX = %d, want %d", m.jsonScrollX, jsonScrollStep)
+	}
+	if !strings.Contains(m.viewDetail(), "↔") {
+		t.Error("footer should show the horizontal scroll indicator")
+	}
+
+	for i := 0; i < 100; i++ {
+		press("l")
+	}
+	if overflow := m.jsonOverflow(); m.jsonScrollX != overflow {
+		t.Errorf("jsonScrollX = %d, want it clamped to %d", m.jsonScrollX, overflow)
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if m.jsonScrollX != 0 {
+		t.Errorf("jsonScrollX = %d after switching tabs, want 0", m.jsonScrollX)
+	}
+}
+
+func TestNoColor_RendersWithoutEscapeCodes(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	dark := lipgloss.HasDarkBackground()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(profile)
+		lipgloss.SetHasDarkBackground(dark)
+	})
+	t.Setenv("NO_COLOR", "")
+
+	DisableColor()
+	if os.Getenv("NO_COLOR") != "1" {
+		t.Error("DisableColor should set NO_COLOR")
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil)).WithNoColor()
+	m.width, m.height = 120, 40
+	m.view = ViewOrders
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: demo.GetDemoOrders()})
+	m = updated.(Model)
+
+	view := m.View()
+	if regexp.MustCompile(`\x1b\[`).MatchString(view) {
+		t.Errorf("view contains ANSI escape codes:\n%q", view)
+	}
+	if strings.ContainsAny(view, "╭╮╰╯") {
+		t.Error("orders table should use ASCII borders")
+	}
+}
+
+func TestHighlightJSON(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	input := "{\n  \"vin\": \"XP7\",\n  \"time\": \"10:30\",\n  \"n\": -1.5e3,\n  \"ok\": true,\n  \"x\": null,\n  \"obj\": {\n  }\n}"
+	got := highlightJSON(input)
+
+	for _, want := range []string{
+		JSONKeyStyle.Render(`"vin"`) + ": " + JSONStringStyle.Render(`"XP7"`) + ",",
+		JSONKeyStyle.Render(`"time"`) + ": " + JSONStringStyle.Render(`"10:30"`) + ",",
+		JSONKeyStyle.Render(`"n"`) + ": " + JSONNumberStyle.Render("-1.5e3") + ",",
+		JSONKeyStyle.Render(`"ok"`) + ": " + JSONBoolStyle.Render("true") + ",",
+		JSONKeyStyle.Render(`"x"`) + ": " + JSONNullStyle.Render("null") + ",",
+		JSONKeyStyle.Render(`"obj"`) + ": {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("highlightJSON() missing %q", want)
+		}
+	}
+	if lipgloss.Width(got) != lipgloss.Width(input) || strings.Count(got, "\n") != strings.Count(input, "\n") {
+		t.Errorf("highlightJSON() changed the layout:\n%s", got)
+	}
+}
+
+func TestDetectRegion_SavesDetectedRegion(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-region-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	client := api.NewMockClient(nil)
+	m := newTestModel(t, client)
+	m.config = cfg
+
+	enc := base64.RawURLEncoding
+	token := enc.EncodeToString([]byte(`{}`)) + "." + enc.EncodeToString([]byte(`{"ou_code":"EU"}`)) + ".sig"
+	tokens := &model.TeslaTokens{AccessToken: token}
+
+	cmd := m.detectRegion(tokens)
+	if client.Region() != config.RegionEU {
+		t.Errorf("client region = %q, want %q", client.Region(), config.RegionEU)
+	}
+	if cmd == nil {
+		t.Fatal("detectRegion() should save a newly detected region")
+	}
+	cmd()
+	if got := cfg.Settings().Region; got != config.RegionEU {
+		t.Errorf("saved region = %q, want %q", got, config.RegionEU)
+	}
+	if cmd := m.detectRegion(tokens); cmd != nil {
+		t.Error("detectRegion() should not save an unchanged region")
+	}
+
+	// --region wins over the token
+	client = api.NewMockClient(nil)
+	m = newTestModel(t, client).WithRegion(config.RegionCN)
+	m.config = cfg
+	if cmd := m.detectRegion(tokens); cmd != nil {
+		t.Error("detectRegion() should do nothing when the region is overridden")
+	}
+	if client.Region() != config.RegionCN {
+		t.Errorf("client region = %q, want %q", client.Region(), config.RegionCN)
+	}
+}
+
+func TestB2BOrderRendering(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.width, m.height = 120, 40
+
+	company := "Acme Fleet B.V."
+	business := demo.GetDemoOrders()[0]
+	business.Order.IsB2B = true
+	business.Order.OwnerCompanyName = &company
+	private := demo.GetDemoOrders()[0]
+	m.orders = []model.CombinedOrder{business, private}
+
+	details := m.renderDetailsTab(business, nil)
+	if !strings.Contains(details, "B2B Order · "+company) {
+		t.Error("Details tab should show the B2B banner with the company name")
+	}
+	if strings.Contains(m.renderDetailsTab(private, nil), "B2B Order") {
+		t.Error("Details tab should not show the B2B banner for private orders")
+	}
+
+	view := m.viewOrders()
+	if got := strings.Count(view, "(Business)"); got != 1 {
+		t.Errorf("orders table shows %d \"(Business)\" suffixes, want 1", got)
+	}
+}
+
+func TestPreOwnedSection(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+
+	used := demo.GetDemoUsedOrder()
+	details := m.renderDetailsTab(used, nil)
+	for _, want := range []string{"Pre-Owned Vehicle", "2022", "Excellent"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("Details tab for a used order missing %q", want)
+		}
+	}
+
+	if strings.Contains(m.renderDetailsTab(demo.GetDemoOrders()[0], nil), "Pre-Owned") {
+		t.Error("Details tab should not show the Pre-Owned section for new orders")
+	}
+
+	m.orders = []model.CombinedOrder{used}
+	m.view = ViewDetail
+	if !strings.Contains(m.View(), "Pre-Owned") {
+		t.Error("detail header should show the Pre-Owned badge")
+	}
+}
+
+func TestHandleMouseEvent_TabClicks(t *testing.T) {
+	_, checklistTotal := storage.CountCompleted(nil)
+	click := func(m Model, x, y int) Model {
+		updated, _ := m.handleMouseEvent(tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionRelease})
+		return updated.(Model)
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		labels []string
+	}{
+		{
+			name:   "without badges",
+			setup:  func(m *Model) { m.selectedOrder = len(m.orders) },
+			labels: []string{"Details", "Tasks", "Checklist", "History", "JSON"},
+		},
+		{
+			name: "with count badges",
+			setup: func(m *Model) {
+				m.demoMode = true
+				m.demoHistory = demo.GetDemoHistory()
+			},
+			labels: []string{"Details", "Tasks", fmt.Sprintf("Checklist 0/%d", checklistTotal), "History (3)", "JSON"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel(t, api.NewMockClient(nil))
+			m.width, m.height = 120, 40
+			m.orders = demo.GetDemoOrders()
+			m.view = ViewDetail
+			tt.setup(&m)
+			m.renderTabs()
+
+			// Tabs are padded by two cells on each side
+			x := appPaddingLeft
+			for i, label := range tt.labels {
+				width := len(label) + 4
+				for _, clickX := range []int{x, x + width/2, x + width - 1} {
+					m.selectedTab = TabJSON
+					if i == int(TabJSON) {
+						m.selectedTab = TabDetails
+					}
+					if got := click(m, clickX, detailTabLine).selectedTab; got != Tab(i) {
+						t.Errorf("click at x=%d selected tab %d, want %d (%s)", clickX, got, i, label)
+					}
+				}
+				x += width
+			}
+
+			m.selectedTab = TabTasks
+			for _, clickX := range []int{0, appPaddingLeft - 1, x, x + 20} {
+				if got := click(m, clickX, detailTabLine).selectedTab; got != TabTasks {
+					t.Errorf("click at x=%d outside the tabs selected tab %d", clickX, got)
+				}
+			}
+			if got := click(m, appPaddingLeft, detailTabLine+1).selectedTab; got != TabTasks {
+				t.Errorf("click below the tabs selected tab %d", got)
+			}
+		})
+	}
+}
+
+func TestDetailFieldFocus_AcknowledgeField(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-acks-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+	acks, err := storage.NewAcknowledgements(tempDir)
+	if err != nil {
+		t.Fatalf("NewAcknowledgements() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil)).WithAcknowledgements(acks)
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	ref := m.orders[0].Order.ReferenceNumber
+	diffs := []model.OrderDiff{
+		{Field: "Delivery Window", OldValue: "Jun - Jul 2026", NewValue: "May - Jun 2026"},
+		{Field: "VIN", OldValue: "N/A", NewValue: m.orders[0].Order.GetVIN()},
+	}
+	if _, err := acks.Record(ref, diffs); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	m.diffs = map[string][]model.OrderDiff{ref: diffs}
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+
+	press := func(m Model, key string) (Model, tea.Cmd) {
+		updated, cmd := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		return updated.(Model), cmd
+	}
+
+	m, _ = press(m, "f")
+	if m.detailFocus {
+		t.Fatal("'f' is the viewport's page down and should not enable field focus")
+	}
+	m, _ = press(m, "F")
+	if !m.detailFocus {
+		t.Fatal("'F' should enable field focus")
+	}
+	m, _ = press(m, "j")
+	if m.detailCursor != 1 {
+		t.Fatalf("detailCursor = %d, want 1", m.detailCursor)
+	}
+
+	m, cmd := press(m, "a")
+	if cmd == nil {
+		t.Fatal("'a' in field focus should acknowledge the field")
+	}
+	msg, ok := cmd().(FieldAcknowledgedMsg)
+	if !ok || msg.Field != "VIN" || msg.Error != nil {
+		t.Fatalf("cmd() = %+v, want FieldAcknowledgedMsg for VIN", msg)
+	}
+	updated, _ := m.Update(msg)
+	m = updated.(Model)
+
+	if got := m.diffs[ref]; len(got) != 1 || got[0].Field != "Delivery Window" {
+		t.Errorf("diffs = %v, want only Delivery Window", got)
+	}
+	if m.detailCursor != 0 {
+		t.Errorf("detailCursor = %d, want clamped to 0", m.detailCursor)
+	}
+	details := m.renderDetailsTab(m.orders[0], m.diffs[ref])
+	if strings.Contains(details, "(was: N/A)") {
+		t.Error("acknowledged VIN change should not be marked in the Details tab")
+	}
+	if !strings.Contains(details, "(was: Jun - Jul 2026)") {
+		t.Error("unacknowledged Delivery Window change should stay marked")
+	}
+	if pending, _ := acks.Unacknowledged(ref); len(pending) != 1 {
+		t.Errorf("persisted pending = %v, want 1 change", pending)
+	}
+
+	// Acknowledging the last change leaves field focus
+	_, cmd = press(m, "a")
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.detailFocus {
+		t.Error("field focus should end when no changes remain")
+	}
+	if _, ok := m.diffs[ref]; ok {
+		t.Error("diffs for the order should be removed once all are acknowledged")
+	}
+}
+
+func TestUpdateCheck(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	if cmd := m.checkForUpdate(); cmd != nil {
+		t.Error("checkForUpdate() should be disabled without WithUpdateCheck")
+	}
+
+	updated, _ := m.Update(UpdateAvailableMsg{Latest: "v1.2.3"})
+	m = updated.(Model)
+	if m.toastMessage != "Update available: v1.2.3" {
+		t.Errorf("toastMessage = %q, want the update hint", m.toastMessage)
+	}
+}
+
+func TestDetailsTab_VehicleArt(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := demo.GetDemoOrders()[0]
+	art := strings.Split(model.GetASCIIArt(order.Order.ModelCode), "\n")[0]
+
+	if !strings.Contains(m.renderDetailsTab(order, nil), art) {
+		t.Error("Details tab should show the vehicle silhouette")
+	}
+	if strings.Contains(m.WithoutArt().renderDetailsTab(order, nil), art) {
+		t.Error("WithoutArt() should hide the vehicle silhouette")
+	}
+}
+
+func TestDetailsTab_SelfSchedulingLink(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := demo.GetDemoOrders()[0]
+	scheduling := *order.Details.Tasks.Scheduling
+	order.Details.Tasks.Scheduling = &scheduling
+
+	scheduling.IsSelfSchedulingAvailable = true
+	scheduling.SelfSchedulingURL = "https://www.tesla.com/teslaaccount/schedule"
+	content := m.renderDetailsTab(order, nil)
+	if !strings.Contains(content, "Schedule now →") || !strings.Contains(content, scheduling.SelfSchedulingURL) {
+		t.Error("Details tab should show the self-scheduling link")
+	}
+
+	scheduling.SelfSchedulingURL = ""
+	if strings.Contains(m.renderDetailsTab(order, nil), "Schedule now →") {
+		t.Error("Details tab should hide the self-scheduling link without a URL")
+	}
+}
+
+func TestDetailKeys_OOpensSchedulingLink(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "tesla-tui-schedule-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cfg, err := config.NewWithDir(tempDir)
+	if err != nil {
+		t.Fatalf("NewWithDir() error = %v", err)
+	}
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.config = cfg
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	m.orders[0].Details.Tasks.Scheduling = &model.SchedulingTask{}
+	m.view = ViewDetail
+	m.selectedTab = TabDetails
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	if got := updated.(Model).toastMessage; got != "No self-scheduling link available" {
+		t.Errorf("toast = %q, want the no link notice", got)
+	}
+
+	m.orders[0].Details.Tasks.Scheduling = &model.SchedulingTask{
+		IsSelfSchedulingAvailable: true,
+		SelfSchedulingURL:         "https://www.tesla.com/teslaaccount/schedule",
+	}
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("O")})
+	if updated.(Model).view != ViewDetail {
+		t.Errorf("view = %v, want ViewDetail when opening the scheduling link", updated.(Model).view)
+	}
+	if cmd == nil {
+		t.Error("O should open the scheduling link on the Details tab")
+	}
+
+	// S always opens settings, even with a scheduling link
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if updated.(Model).view != ViewSettings {
+		t.Errorf("view = %v, want ViewSettings", updated.(Model).view)
+	}
+}
+
+func TestRenderDeliveryGates_InsuranceTask(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+
+	order := demo.GetDemoOrders()[0]
+	order.Details.Tasks.Insurance = nil
+	raw := make(map[string]json.RawMessage, len(order.Details.Tasks.Raw))
+	for name, task := range order.Details.Tasks.Raw {
+		raw[name] = task
+	}
+	order.Details.Tasks.Raw = raw
+
+	// The gate follows the task, whether or not it is enabled yet
+	raw["insurance"] = json.RawMessage(`{"complete": false, "required": false}`)
+	if !strings.Contains(m.renderDeliveryGates(order), "Insurance") {
+		t.Error("insurance gate should be shown when the order has an insurance task")
+	}
+
+	delete(raw, "insurance")
+	if strings.Contains(m.renderDeliveryGates(order), "Insurance") {
+		t.Error("insurance gate should be hidden without an insurance task")
+	}
+}
+
+func TestRenderOrderTimeline_ConfigureStage(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+
+	order := model.CombinedOrder{Order: model.TeslaOrder{OrderStatus: "RESERVED"}}
+	content := m.renderOrderTimeline(order, model.OrderTimeline{}, nil)
+	configure := strings.Index(content, model.StageConfigure)
+	if configure < 0 || configure > strings.Index(content, model.StageVINAssigned) {
+		t.Fatal("timeline should show Configure before VIN Assigned")
+	}
+	if !strings.Contains(content, "◐ "+model.StageConfigure) {
+		t.Error("Configure should be the current stage for an unconfigured order")
+	}
+
+	options := "APBS,MDLY"
+	order.Order.MktOptions = &options
+	content = m.renderOrderTimeline(order, model.OrderTimeline{}, nil)
+	if !strings.Contains(content, "● "+model.StageConfigure) {
+		t.Error("Configure should be complete once the order has options")
+	}
+	if !strings.Contains(content, "◐ "+model.StageVINAssigned) {
+		t.Error("VIN Assigned should be the current stage after configuring")
+	}
+}
+
+func TestRenderOrderTimeline_StageDates(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+
+	order := demo.GetDemoOrders()[0]
+	timeline := model.OrderTimeline{StageTimings: map[string]time.Duration{
+		model.StageVINAssigned: 14 * 24 * time.Hour,
+	}}
+	timestamps := map[string]time.Time{
+		model.StageVINAssigned: time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC),
+		model.StageDelivered:   time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC),
+	}
+
+	content := m.renderOrderTimeline(order, timeline, timestamps)
+	if !strings.Contains(content, model.StageVINAssigned+" (Mar 15, 14 days after order)") {
+		t.Errorf("VIN Assigned should show its date and timing, got:\n%s", content)
+	}
+	if strings.Contains(content, "Jun 15") {
+		t.Error("incomplete stages should not show a date")
+	}
+}
+
+func TestChecklistTab_ProgressCountsAllSections(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := demo.GetDemoOrders()[0]
+
+	state := &storage.ChecklistState{ReferenceNumber: order.Order.ReferenceNumber, Checked: make(map[string]bool)}
+	total := 0
+	for _, section := range storage.DeliveryChecklist {
+		total += len(section.Items)
+	}
+	for _, section := range storage.DeliveryChecklist {
+		if section.Title == "Interior Inspection" {
+			for _, item := range section.Items {
+				state.Checked[item.ID] = true
+			}
+		}
+	}
+	m.checklistState = state
+
+	content := m.renderChecklistTab(order)
+	want := fmt.Sprintf("Progress: 10/%d", total)
+	if !strings.Contains(content, want) {
+		t.Errorf("checklist tab missing %q", want)
+	}
+	if !strings.Contains(content, "Interior Inspection") || !strings.Contains(content, "(10/10)") {
+		t.Error("checklist tab should show the completed Interior Inspection section")
+	}
+}
+
+func TestChecklistTab_RendersSectionHeaders(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+
+	content := m.renderChecklistTab(demo.GetDemoOrders()[0])
+	for _, section := range storage.DeliveryChecklist {
+		if !strings.Contains(content, section.Title) {
+			t.Errorf("checklist tab missing section %q", section.Title)
+		}
+	}
+}
+
+func TestChecklistTab_Undo(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	m.view = ViewDetail
+	m.selectedTab = TabChecklist
+	ref := m.orders[0].Order.ReferenceNumber
+
+	// press sends a key and feeds the resulting toggle back into Update
+	press := func(k tea.KeyMsg) {
+		t.Helper()
+		updated, cmd := m.Update(k)
+		m = updated.(Model)
+		for _, msg := range collectMsgs(cmd) {
+			if toggle, ok := msg.(ChecklistToggleMsg); ok {
+				updated, _ = m.Update(toggle)
+				m = updated.(Model)
+			}
+		}
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	undo := tea.KeyMsg{Type: tea.KeyCtrlZ}
+	checked := func(id string) bool {
+		state, err := m.checklist.LoadState(ref)
+		if err != nil {
+			t.Fatalf("LoadState() error = %v", err)
+		}
+		return state.Checked[id]
+	}
+
+	var items []string
+	for i, row := range m.checklistRows() {
+		if row.item >= 0 {
+			items = append(items, storage.DeliveryChecklist[row.section].Items[row.item].ID)
+			if len(items) == 1 {
+				m.checklistCursor = i
+			}
+		}
+	}
+	first, second := items[0], items[1]
+
+	press(enter) // check first
+	m.checklistCursor++
+	press(enter) // check second
+	if !checked(first) || !checked(second) {
+		t.Fatal("both items should be checked")
+	}
+
+	press(undo)
+	if !checked(first) || checked(second) {
+		t.Error("undo should uncheck only the last toggled item")
+	}
+
+	press(enter) // redo by checking second again
+	press(enter) // and uncheck it
+	press(undo)
+	if !checked(second) {
+		t.Error("undo should re-check the item that was just unchecked")
+	}
+
+	press(undo)
+	press(undo)
+	if checked(first) || checked(second) {
+		t.Error("undoing every toggle should leave both items unchecked")
+	}
+	if len(m.checklistUndo) != 0 {
+		t.Errorf("undo stack has %d entries, want 0", len(m.checklistUndo))
+	}
+
+	press(undo)
+	if m.toastMessage != "Nothing to undo" {
+		t.Errorf("toast = %q, want %q", m.toastMessage, "Nothing to undo")
+	}
+}
+
+func TestPushChecklistUndo_KeepsNewestEntries(t *testing.T) {
+	var m Model
+	for i := 0; i < maxChecklistUndo+3; i++ {
+		m.pushChecklistUndo(checklistUndoEntry{ItemID: fmt.Sprintf("item_%d", i)})
+	}
+	if len(m.checklistUndo) != maxChecklistUndo {
+		t.Fatalf("undo stack has %d entries, want %d", len(m.checklistUndo), maxChecklistUndo)
+	}
+	if m.checklistUndo[0].ItemID != "item_3" {
+		t.Errorf("oldest entry = %q, want item_3", m.checklistUndo[0].ItemID)
+	}
+}
+
+func TestChecklistTab_ModelSpecificSections(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := demo.GetDemoOrders()[0]
+	order.Order.ModelCode = "mx"
+	m.orders = []model.CombinedOrder{order}
+
+	_, total := storage.CountCompletedIn(storage.ChecklistFor("mx"), nil)
+	content := m.renderChecklistTab(order)
+	if !strings.Contains(content, "Model X Falcon Wing Doors") {
+		t.Error("checklist tab should show the Model X section")
+	}
+	if want := fmt.Sprintf("Progress: 0/%d", total); !strings.Contains(content, want) {
+		t.Errorf("checklist tab missing %q", want)
+	}
+	if rows := m.checklistRows(); rows[len(rows)-1].item < 0 {
+		t.Error("checklist rows should include the Model X items")
+	}
+}
+
+func TestTasksTab_CursorAndActionLink(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}
+	order.Details.Tasks.Raw = map[string]json.RawMessage{
+		"registration": json.RawMessage(`{"order": 1, "complete": true}`),
+		"scheduling":   json.RawMessage(`{"order": 2, "card": {"title": "Schedule", "buttonText": {"cta": "Schedule Delivery", "target": "https://www.tesla.com/teslaaccount/schedule"}}}`),
+	}
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabTasks
+
+	_, cmd := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if msg, ok := cmd().(ToastMsg); !ok || !msg.IsError {
+		t.Errorf("expected an error toast for a task without a link, got %+v", msg)
+	}
+
+	updated, _ := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = updated.(Model)
+	if m.taskCursor != 1 {
+		t.Fatalf("taskCursor = %d, want 1", m.taskCursor)
+	}
+	updated, _ = m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = updated.(Model)
+	if m.taskCursor != 1 {
+		t.Errorf("taskCursor = %d, want 1 at the last task", m.taskCursor)
+	}
+
+	if content := m.renderTasksTab(order); !strings.Contains(content, "o: open Schedule Delivery") {
+		t.Error("tasks tab should show the focused task's action link")
+	}
+
+	m.selectedTab = TabDetails
+	m.onTabSwitch()
+	m.selectedTab = TabTasks
+	m.onTabSwitch()
+	if m.taskCursor != 0 {
+		t.Errorf("taskCursor = %d after switching tabs, want 0", m.taskCursor)
+	}
+}
+
+func TestTasksTab_TaskDetailOverlay(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.viewport.Width, m.viewport.Height = 116, 28
+	order := model.CombinedOrder{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}
+	order.Details.Tasks.Raw = map[string]json.RawMessage{
+		"financing": json.RawMessage(`{"order": 1, "card": {"title": "Financing", "subtitle": "Payment method selected", "messageTitle": "Pay With", "messageBody": "Cash", "buttonText": {"cta": "Manage Payment", "target": "https://www.tesla.com/teslaaccount/payment"}}}`),
+	}
+	m.orders = []model.CombinedOrder{order}
+	m.view = ViewDetail
+	m.selectedTab = TabTasks
+
+	updated, _ := m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	m = updated.(Model)
+	if !m.taskDetailOverlay || m.focusedTask != "financing" {
+		t.Fatalf("taskDetailOverlay = %v, focusedTask = %q; want the financing overlay", m.taskDetailOverlay, m.focusedTask)
+	}
+	content := m.getTabContent()
+	for _, want := range []string{"Financing", "Payment method selected", "Pay With", "Cash", "Manage Payment", "https://www.tesla.com/teslaaccount/payment"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("overlay missing %q", want)
+		}
+	}
+
+	updated, _ = m.handleDetailKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.taskDetailOverlay || m.view != ViewDetail {
+		t.Errorf("esc should close the overlay and stay in the detail view, got overlay = %v, view = %v", m.taskDetailOverlay, m.view)
+	}
+	if strings.Contains(m.getTabContent(), "Pay With") {
+		t.Error("tasks tab should be back to the task list")
+	}
+}
+
+func TestFormatAppointmentDate(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	appt := &model.AppointmentDetails{Date: "March 7, 2025", Time: "10:00 AM"}
+
+	tests := []struct {
+		dateFormat string
+		want       string
+	}{
+		{format.DateLongUS, "March 7, 2025"},
+		{format.DateISO, "2025-03-07"},
+		{format.DateEU, "07.03.2025"},
+		{format.DateShort, "Mar 7, 2025"},
+	}
+	for _, tt := range tests {
+		m.dateFormat = tt.dateFormat
+		if got := m.formatAppointmentDate(appt); got != tt.want {
+			t.Errorf("formatAppointmentDate() with %q = %q, want %q", tt.dateFormat, got, tt.want)
+		}
+	}
+
+	m.dateFormat = format.DateISO
+	if got := m.formatAppointmentDate(&model.AppointmentDetails{Date: "Next week"}); got != "Next week" {
+		t.Errorf("formatAppointmentDate() = %q, want the unparsed date", got)
+	}
+}
+
+func TestParseTradeInExpiry(t *testing.T) {
+	if got := parseTradeInExpiry("2026-03-15"); got == nil || !got.Equal(time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("parseTradeInExpiry(2026-03-15) = %v", got)
+	}
+	if got := parseTradeInExpiry("2026-03-15T10:00:00Z"); got == nil || got.Day() != 15 {
+		t.Errorf("parseTradeInExpiry(RFC3339) = %v", got)
+	}
+	if got := parseTradeInExpiry("soon"); got != nil {
+		t.Errorf("parseTradeInExpiry(soon) = %v, want nil", got)
+	}
+}
+
+func TestRenderTradeInExpiry(t *testing.T) {
+	now := time.Date(2026, time.March, 8, 18, 30, 0, 0, time.UTC)
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2026-03-15", "2026-03-15"},
+		{"2026-03-14", "⚠ Expires in 6 days!"},
+		{"2026-03-09", "⚠ Expires in 1 day!"},
+		{"2026-03-08", "⚠ Expires today!"},
+		{"2026-03-07", "Offer expired"},
+		{"next month", "next month"},
+	}
+	for _, tt := range tests {
+		if got := renderTradeInExpiry(tt.date, now); got != tt.want {
+			t.Errorf("renderTradeInExpiry(%q) = %q, want %q", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestHasCriticalChange(t *testing.T) {
+	tests := []struct {
+		name  string
+		diffs map[string][]model.OrderDiff
+		want  bool
+	}{
+		{"no diffs", nil, false},
+		{"VIN assigned", map[string][]model.OrderDiff{"RN1": {{Field: "VIN", OldValue: "N/A", NewValue: "7SAYGDEE1PA123456"}}}, true},
+		{"appointment booked", map[string][]model.OrderDiff{"RN1": {{Field: "Delivery Appointment", OldValue: "N/A", NewValue: "March 7, 2025 at 10:00 AM"}}}, true},
+		{"appointment removed", map[string][]model.OrderDiff{"RN1": {{Field: "Delivery Appointment", OldValue: "March 7, 2025 at 10:00 AM", NewValue: "N/A"}}}, false},
+		{"other change", map[string][]model.OrderDiff{"RN1": {{Field: "Vehicle Location", OldValue: "Berlin", NewValue: "Tilburg"}}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasCriticalChange(tt.diffs); got != tt.want {
+				t.Errorf("hasCriticalChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdersLoaded_SoundAlert(t *testing.T) {
+	alerts := 0
+	orig := playAlert
+	playAlert = func() tea.Cmd {
+		alerts++
+		return nil
+	}
+	defer func() { playAlert = orig }()
+
+	vinAssigned := map[string][]model.OrderDiff{"RN1": {{Field: "VIN", OldValue: "N/A", NewValue: "7SAYGDEE1PA123456"}}}
+	locationChanged := map[string][]model.OrderDiff{"RN1": {{Field: "Vehicle Location", OldValue: "Berlin", NewValue: "Tilburg"}}}
+
+	tests := []struct {
+		name       string
+		soundAlert bool
+		diffs      map[string][]model.OrderDiff
+		wantAlerts int
+	}{
+		{"enabled with VIN change", true, vinAssigned, 1},
+		{"enabled without critical change", true, locationChanged, 0},
+		{"disabled with VIN change", false, vinAssigned, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts = 0
+			m := newTestModel(t, api.NewMockClient(nil))
+			m.soundAlert = tt.soundAlert
+			m.Update(OrdersLoadedMsg{Orders: []model.CombinedOrder{{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}}, Diffs: tt.diffs, NewDiffs: tt.diffs})
+			if alerts != tt.wantAlerts {
+				t.Errorf("playAlert called %d times, want %d", alerts, tt.wantAlerts)
+			}
+		})
+	}
+
+	t.Run("pending change from an earlier refresh", func(t *testing.T) {
+		alerts = 0
+		m := newTestModel(t, api.NewMockClient(nil))
+		m.soundAlert = true
+		m.Update(OrdersLoadedMsg{Orders: []model.CombinedOrder{{Order: model.TeslaOrder{ReferenceNumber: "RN1"}}}, Diffs: vinAssigned})
+		if alerts != 0 {
+			t.Errorf("playAlert called %d times for an unacknowledged old change, want 0", alerts)
+		}
+	})
+}
+
+func TestWithSoundAlert(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	if m.soundAlert {
+		t.Error("sound alert should be off by default")
+	}
+	if !m.WithSoundAlert().soundAlert {
+		t.Error("WithSoundAlert() should enable the sound alert")
+	}
+}
+
+func TestOrdersView_ChangeTooltipOnHover(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.view = ViewOrders
+	m.orders = demo.GetDemoOrders()
+	m.diffs = demo.GetDemoDiffs()
+
+	// Render once so the Changed column position is known
+	view := m.View()
+	if strings.Contains(view, "Delivery Window, Vehicle Location, VIN") {
+		t.Fatal("tooltip should not show before hovering")
+	}
+	rowY := -1
+	for i, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "▸ Model Y") {
+			rowY = i
+			break
+		}
+	}
+	if rowY != ordersTableFirstRow {
+		t.Fatalf("first order row is on line %d, want %d", rowY, ordersTableFirstRow)
+	}
+	bounds := *m.changedColumnX
+	if bounds[1] <= bounds[0] {
+		t.Fatalf("changedColumnX = %v, want a non-empty range", bounds)
+	}
+
+	hover := func(x, y int) Model {
+		updated, _ := m.handleMouseEvent(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionMotion, Button: tea.MouseButtonNone})
+		return updated.(Model)
+	}
+
+	hovered := hover(bounds[0]+2, ordersTableFirstRow)
+	if hovered.hoveredOrder != 0 {
+		t.Fatalf("hoveredOrder = %d, want 0", hovered.hoveredOrder)
+	}
+	view = hovered.View()
+	if !strings.Contains(view, "Delivery Window, Vehicle Location, VIN") {
+		t.Errorf("view should show the changed fields, got:\n%s", view)
+	}
+
+	if moved := hover(0, ordersTableFirstRow); moved.hoveredOrder != -1 {
+		t.Errorf("hoveredOrder = %d outside the Changed column, want -1", moved.hoveredOrder)
+	}
+}
+
+func TestChangedFieldsSummary(t *testing.T) {
+	diffs := []model.OrderDiff{{Field: "VIN"}, {Field: "Delivery Window"}, {Field: "Vehicle Location"}, {Field: "Odometer"}, {Field: "Amount Due"}}
+
+	if got, want := changedFieldsSummary(diffs[:2]), "VIN, Delivery Window"; got != want {
+		t.Errorf("changedFieldsSummary() = %q, want %q", got, want)
+	}
+	if got, want := changedFieldsSummary(diffs), "VIN, Delivery Window, Vehicle Location (+2 more)"; got != want {
+		t.Errorf("changedFieldsSummary() = %q, want %q", got, want)
+	}
+}
+
+func TestOrdersKeys_CopyReferenceNumber(t *testing.T) {
+	var copied string
+	orig := copyToClipboard
+	copyToClipboard = func(text string) tea.Cmd {
+		return func() tea.Msg {
+			copied = text
+			return ClipboardMsg{Text: text, Success: true}
+		}
+	}
+	defer func() { copyToClipboard = orig }()
+
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	m.orders = []model.CombinedOrder{
+		{Order: model.TeslaOrder{ReferenceNumber: "RN111111111"}},
+		{Order: model.TeslaOrder{ReferenceNumber: "RN123456789"}},
+	}
+	m.selectedOrder = 1
+
+	_, cmd := m.handleOrdersKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	if cmd == nil {
+		t.Fatal("expected a copy command")
+	}
+	msg := cmd()
+	if copied != "RN123456789" {
+		t.Errorf("copied %q, want RN123456789", copied)
+	}
+
+	updated, _ := m.Update(msg)
+	if got := updated.(Model).toastMessage; got != "✓ Copied: RN123456789" {
+		t.Errorf("toastMessage = %q, want %q", got, "✓ Copied: RN123456789")
+	}
+}
+
+func TestForceRefresh_BypassesRateLimiter(t *testing.T) {
+	for _, view := range []View{ViewOrders, ViewDetail} {
+		client := api.NewMockClient(demo.GetDemoOrders())
+		m := newTestModel(t, client)
+		m.orders = demo.GetDemoOrders()
+		m.view = view
+		// A refresh moments ago doesn't hold back a forced refresh
+		m.lastRefresh = time.Now()
+
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+		if !updated.(Model).loading {
+			t.Errorf("view %d: ctrl+r should start loading", view)
+		}
+		if cmd == nil {
+			t.Fatalf("view %d: ctrl+r returned no command", view)
+		}
+		for _, c := range cmd().(tea.BatchMsg) {
+			if msg, ok := c().(OrdersLoadedMsg); ok && msg.Error != nil {
+				t.Errorf("view %d: forced refresh error = %v", view, msg.Error)
+			}
+		}
+		if got := client.CallCount("ForceGetAllOrderData"); got != 1 {
+			t.Errorf("view %d: ForceGetAllOrderData called %d times, want 1", view, got)
+		}
+		if got := client.CallCount("GetAllOrderData"); got != 0 {
+			t.Errorf("view %d: GetAllOrderData called %d times, want 0", view, got)
+		}
+	}
+}
+
+func TestKeyMap_ForceRefresh(t *testing.T) {
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyCtrlR}, DefaultKeyMap.ForceRefresh) {
+		t.Error("ForceRefresh should match ctrl+r")
+	}
+	if got := DefaultKeyMap.ForceRefresh.Help(); got.Key != "ctrl+r" || got.Desc != "force refresh" {
+		t.Errorf("ForceRefresh help = %+v, want ctrl+r: force refresh", got)
+	}
+}
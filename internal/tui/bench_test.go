@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"os"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
+)
+
+// Run with:
+//
+//	go test ./internal/tui -run '^$' -bench . -benchmem
+//
+// Baseline for the demo order. Timings vary between machines, so compare
+// allocations first:
+//
+//	BenchmarkRenderDetailsTab   ~520 KB/op   9057 allocs/op
+//	BenchmarkRenderJSONTab      ~102 KB/op   4022 allocs/op
+//	BenchmarkHighlightJSON       ~93 KB/op   4008 allocs/op
+
+// newBenchModel creates a demo-mode Model rendering with true colour, so the
+// styling cost is included
+func newBenchModel(b *testing.B) Model {
+	b.Helper()
+
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	b.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	tempDir, err := os.MkdirTemp("", "tesla-tui-bench-*")
+	if err != nil {
+		b.Fatalf("Failed to create temp dir: %v", err)
+	}
+	b.Cleanup(func() { os.RemoveAll(tempDir) })
+
+	hist, err := storage.NewHistory(tempDir)
+	if err != nil {
+		b.Fatalf("NewHistory() error = %v", err)
+	}
+	cl, err := storage.NewChecklist(tempDir)
+	if err != nil {
+		b.Fatalf("NewChecklist() error = %v", err)
+	}
+
+	m := New(nil, api.NewMockClient(nil), hist, cl).WithDemoMode()
+	m.width = 120
+	m.orders = demo.GetDemoOrders()
+	m.diffs = demo.GetDemoDiffs()
+	m.demoHistory = demo.GetDemoHistory()
+	return m
+}
+
+func BenchmarkRenderDetailsTab(b *testing.B) {
+	m := newBenchModel(b)
+	order := m.orders[0]
+	diffs := m.diffs[order.Order.ReferenceNumber]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.renderDetailsTab(order, diffs)
+	}
+}
+
+func BenchmarkRenderJSONTab(b *testing.B) {
+	m := newBenchModel(b)
+	order := m.orders[0]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.renderJSONTab(order)
+	}
+}
+
+func BenchmarkHighlightJSON(b *testing.B) {
+	m := newBenchModel(b)
+	text, err := jsonTabText(m.orders[0])
+	if err != nil {
+		b.Fatalf("jsonTabText() error = %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		highlightJSON(text)
+	}
+}
@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/data"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// orderColumn describes a column in the orders table
+type orderColumn struct {
+	name  string
+	value func(order model.CombinedOrder, changed bool) string
+}
+
+// orderColumns lists every column the orders table can show
+var orderColumns = []orderColumn{
+	{"Model", func(o model.CombinedOrder, _ bool) string {
+		if o.Order.IsB2B {
+			return o.Order.GetModelName() + " (Business)"
+		}
+		return o.Order.GetModelName()
+	}},
+	{"Year", func(o model.CombinedOrder, _ bool) string { return modelYear(o) }},
+	{"Status", func(o model.CombinedOrder, _ bool) string { return o.Order.OrderStatus }},
+	{"VIN", func(o model.CombinedOrder, _ bool) string {
+		vin := o.Order.GetVIN()
+		if vin == "N/A" && !o.Order.IsConfigured() {
+			return "Configuring…"
+		}
+		if len(vin) > 17 {
+			vin = vin[:17]
+		}
+		return vin
+	}},
+	{"Delivery Window", func(o model.CombinedOrder, _ bool) string {
+		return truncate(o.GetDeliveryWindow(), 25)
+	}},
+	{"Delivery Center", func(o model.CombinedOrder, _ bool) string {
+		return truncate(data.GetStoreName(o.GetDeliveryCenter()), 25)
+	}},
+	{"Ready Score", func(o model.CombinedOrder, _ bool) string { return readyScore(o) }},
+	{"Changed", func(_ model.CombinedOrder, changed bool) string {
+		if changed {
+			return "✓"
+		}
+		return " "
+	}},
+}
+
+// modelYear returns the model year decoded from the order's VIN, or "-"
+// when there is no VIN yet
+func modelYear(o model.CombinedOrder) string {
+	if o.Order.VIN == nil {
+		return "-"
+	}
+	info := model.DecodeVIN(*o.Order.VIN)
+	if info == nil {
+		return "-"
+	}
+	return info.ModelYear
+}
+
+// defaultOrderColumns are shown when no columns are configured
+var defaultOrderColumns = []string{"Model", "Status", "VIN", "Delivery Window", "Changed"}
+
+// resolveColumns maps configured column names to columns, matching names
+// case-insensitively. Unknown names are returned so they can be reported.
+// An empty or entirely unknown list falls back to the default columns.
+func resolveColumns(names []string) ([]orderColumn, []string) {
+	var cols []orderColumn
+	var unknown []string
+
+	for _, name := range names {
+		found := false
+		for _, col := range orderColumns {
+			if strings.EqualFold(strings.TrimSpace(name), col.name) {
+				cols = append(cols, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(cols) == 0 {
+		cols, _ = resolveColumns(defaultOrderColumns)
+	}
+	return cols, unknown
+}
+
+// readyScore returns how many of the order's tasks are complete, e.g. "3/5"
+func readyScore(order model.CombinedOrder) string {
+	total, completed := 0, 0
+	for _, raw := range order.Details.Tasks.Raw {
+		var task struct {
+			Complete *bool `json:"complete"`
+		}
+		if err := json.Unmarshal(raw, &task); err != nil || task.Complete == nil {
+			continue
+		}
+		total++
+		if *task.Complete {
+			completed++
+		}
+	}
+	if total == 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d/%d", completed, total)
+}
+
+// truncate shortens s to at most n columns of display width, adding an
+// ellipsis
+func truncate(s string, n int) string {
+	return ansi.Truncate(s, n, "...")
+}
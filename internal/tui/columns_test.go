@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+func columnNames(cols []orderColumn) []string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.name
+	}
+	return names
+}
+
+func TestResolveColumns(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []string
+		wantCols    []string
+		wantUnknown []string
+	}{
+		{"empty uses defaults", nil, defaultOrderColumns, nil},
+		{"subset in given order", []string{"VIN", "Model"}, []string{"VIN", "Model"}, nil},
+		{"case insensitive", []string{"delivery center", "READY SCORE"}, []string{"Delivery Center", "Ready Score"}, nil},
+		{"unknown reported", []string{"Model", "Color"}, []string{"Model"}, []string{"Color"}},
+		{"all unknown falls back", []string{"Color"}, defaultOrderColumns, []string{"Color"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cols, unknown := resolveColumns(tt.input)
+			if got := columnNames(cols); !reflect.DeepEqual(got, tt.wantCols) {
+				t.Errorf("resolveColumns() columns = %v, want %v", got, tt.wantCols)
+			}
+			if !reflect.DeepEqual(unknown, tt.wantUnknown) {
+				t.Errorf("resolveColumns() unknown = %v, want %v", unknown, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestVINColumn_Configuring(t *testing.T) {
+	cols, _ := resolveColumns([]string{"VIN"})
+	vinColumn := cols[0]
+
+	options := "APBS,MDLY"
+	vin := "7SAYGDEE1PA123456"
+	tests := []struct {
+		name  string
+		order model.TeslaOrder
+		want  string
+	}{
+		{"not configured", model.TeslaOrder{}, "Configuring…"},
+		{"configured without VIN", model.TeslaOrder{MktOptions: &options}, "N/A"},
+		{"VIN assigned", model.TeslaOrder{VIN: &vin}, vin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vinColumn.value(model.CombinedOrder{Order: tt.order}, false); got != tt.want {
+				t.Errorf("VIN column = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelYear(t *testing.T) {
+	order := demo.GetDemoOrders()[0]
+	if got := modelYear(order); got != "2026" {
+		t.Errorf("modelYear() = %q, want 2026 for the demo order", got)
+	}
+
+	order.Order.VIN = nil
+	if got := modelYear(order); got != "-" {
+		t.Errorf("modelYear() = %q, want - without a VIN", got)
+	}
+}
+
+func TestYearColumn_HiddenByDefault(t *testing.T) {
+	cols, _ := resolveColumns(nil)
+	for _, name := range columnNames(cols) {
+		if name == "Year" {
+			t.Error("the Year column should not be shown by default")
+		}
+	}
+	cols, unknown := resolveColumns([]string{"Model", "year"})
+	if len(unknown) != 0 || !reflect.DeepEqual(columnNames(cols), []string{"Model", "Year"}) {
+		t.Errorf("resolveColumns() = %v (unknown %v), want [Model Year]", columnNames(cols), unknown)
+	}
+}
+
+func TestReadyScore(t *testing.T) {
+	order := model.CombinedOrder{}
+	if got := readyScore(order); got != "N/A" {
+		t.Errorf("readyScore() = %q, want N/A without tasks", got)
+	}
+
+	order.Details.Tasks.Raw = map[string]json.RawMessage{
+		"registration": json.RawMessage(`{"complete": true}`),
+		"scheduling":   json.RawMessage(`{"complete": false}`),
+		"finalPayment": json.RawMessage(`{"complete": true}`),
+		"strings":      json.RawMessage(`{"title": "not a task"}`),
+	}
+	if got := readyScore(order); got != "2/3" {
+		t.Errorf("readyScore() = %q, want 2/3", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"Utrecht", 25, "Utrecht"},
+		{"Utrecht - Eendrachtlaan 12 Noord", 25, "Utrecht - Eendrachtlaa..."},
+		// Multi-byte characters count once, and are never split
+		{"München Freiham Süd Lieferzentrum", 25, "München Freiham Süd Li..."},
+		{"Tesla 北京 交付中心 朝阳区 望京", 12, "Tesla 北..."},
+	}
+	for _, tt := range tests {
+		got := truncate(tt.s, tt.n)
+		if got != tt.want {
+			t.Errorf("truncate(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+		if w := ansi.StringWidth(got); w > tt.n {
+			t.Errorf("truncate(%q, %d) is %d columns wide", tt.s, tt.n, w)
+		}
+	}
+}
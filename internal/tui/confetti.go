@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// Confetti animation shown when an order is delivered: ticks every
+// confettiInterval for about two seconds
+const (
+	confettiInterval = 60 * time.Millisecond
+	confettiFrames   = int(2 * time.Second / confettiInterval)
+	confettiLines    = 3 // rows of confetti above and below the banner
+)
+
+var (
+	confettiBlocks = []string{"█", "▓", "▒", "░", " ", "▪", " "}
+	confettiColors = []lipgloss.Color{TeslaRed, StatusBlue, StatusYellow, StatusGreen, Highlight, TeslaWhite}
+)
+
+// becameDelivered reports whether an order status changed from a
+// non-delivered value to a delivered one since the previous load. Diffs
+// stay pending until acknowledged, so the status the order had in previous
+// decides whether the change is new; orders not in previous (such as on
+// the first load) never count.
+func becameDelivered(previous []model.CombinedOrder, diffs map[string][]model.OrderDiff) bool {
+	for _, order := range previous {
+		if isDeliveredStatus(order.Order.OrderStatus) {
+			continue
+		}
+		for _, diff := range diffs[order.Order.ReferenceNumber] {
+			if diff.Field != "Order Status" {
+				continue
+			}
+			oldValue, _ := diff.OldValue.(string)
+			newValue, _ := diff.NewValue.(string)
+			if !isDeliveredStatus(oldValue) && isDeliveredStatus(newValue) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isDeliveredStatus reports whether an order status means delivered. It
+// matches like GetStatusBadgeStyle, so statuses such as READY_FOR_DELIVERY
+// don't count.
+func isDeliveredStatus(status string) bool {
+	return containsAny(status, "delivered")
+}
+
+// confettiTick schedules the next frame of the confetti animation
+func confettiTick() tea.Cmd {
+	return tea.Tick(confettiInterval, func(t time.Time) tea.Msg {
+		return ConfettiTickMsg(t)
+	})
+}
+
+// renderConfetti renders a frame of the confetti animation around a
+// "Delivery day!" banner, filling width columns
+func renderConfetti(width, frame int) string {
+	if width < 1 {
+		width = 1
+	}
+	row := func(line int) string {
+		var b strings.Builder
+		for col := 0; col < width; col++ {
+			// Cheap deterministic scatter that shifts every frame
+			n := col*7 + line*13 + frame*5 + (col*line+frame)%3
+			block := confettiBlocks[n%len(confettiBlocks)]
+			color := confettiColors[(col+line+frame)%len(confettiColors)]
+			b.WriteString(lipgloss.NewStyle().Foreground(color).Render(block))
+		}
+		return b.String()
+	}
+
+	var rows []string
+	for line := 0; line < confettiLines; line++ {
+		rows = append(rows, row(line))
+	}
+	banner := SuccessStyle.Bold(true).Render("🎉 Delivery day! 🎉")
+	rows = append(rows, "", lipgloss.PlaceHorizontal(width, lipgloss.Center, banner), "")
+	for line := confettiLines; line < 2*confettiLines; line++ {
+		rows = append(rows, row(line))
+	}
+	return strings.Join(rows, "\n")
+}
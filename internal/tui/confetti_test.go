@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
+
+// deliveredOrders returns the demo orders with the first one delivered, and
+// the diff recording the status change
+func deliveredOrders() ([]model.CombinedOrder, map[string][]model.OrderDiff) {
+	orders := demo.GetDemoOrders()
+	orders[0].Order.OrderStatus = "DELIVERED"
+	diffs := map[string][]model.OrderDiff{
+		orders[0].Order.ReferenceNumber: {{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}},
+	}
+	return orders, diffs
+}
+
+func TestBecameDelivered(t *testing.T) {
+	booked := demo.GetDemoOrders()
+	ref := booked[0].Order.ReferenceNumber
+	delivered, _ := deliveredOrders()
+
+	tests := []struct {
+		name     string
+		previous []model.CombinedOrder
+		diff     model.OrderDiff
+		want     bool
+	}{
+		{"booked to delivered", booked, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}, true},
+		{"case insensitive", booked, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "Delivered"}, true},
+		{"no previous load", nil, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}, false},
+		{"already delivered", delivered, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "DELIVERED"}, false},
+		{"other status", booked, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "IN_PROGRESS"}, false},
+		{"ready for delivery", booked, model.OrderDiff{Field: "Order Status", OldValue: "BOOKED", NewValue: "READY_FOR_DELIVERY"}, false},
+		{"other field", booked, model.OrderDiff{Field: "VIN", OldValue: "N/A", NewValue: "DELIVERED"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs := map[string][]model.OrderDiff{ref: {tt.diff}}
+			if got := becameDelivered(tt.previous, diffs); got != tt.want {
+				t.Errorf("becameDelivered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfetti_NotOnFirstLoad(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.view = ViewOrders
+	orders, diffs := deliveredOrders()
+
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: orders, Diffs: diffs})
+	if got := updated.(Model); got.animationFrame != 0 {
+		t.Errorf("animationFrame = %d, want 0 on first load", got.animationFrame)
+	}
+}
+
+func TestConfetti_OnStatusTransition(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.view = ViewOrders
+	updated, _ := m.Update(OrdersLoadedMsg{Orders: demo.GetDemoOrders()})
+	m = updated.(Model)
+
+	orders, diffs := deliveredOrders()
+	updated, cmd := m.Update(OrdersLoadedMsg{Orders: orders, Diffs: diffs})
+	m = updated.(Model)
+	if m.animationFrame != 1 {
+		t.Fatalf("animationFrame = %d, want 1 after delivery", m.animationFrame)
+	}
+	if !strings.Contains(m.View(), "Delivery day!") {
+		t.Error("orders view should show the confetti banner")
+	}
+
+	var ticked bool
+	for _, msg := range collectMsgs(cmd) {
+		if _, ok := msg.(ConfettiTickMsg); ok {
+			ticked = true
+		}
+	}
+	if !ticked {
+		t.Error("expected a ConfettiTickMsg to be scheduled")
+	}
+
+	// The same pending diff on the next refresh doesn't replay the animation
+	m.animationFrame = 0
+	updated, _ = m.Update(OrdersLoadedMsg{Orders: orders, Diffs: diffs})
+	if got := updated.(Model); got.animationFrame != 0 {
+		t.Errorf("animationFrame = %d, want 0 for an already delivered order", got.animationFrame)
+	}
+}
+
+func TestConfetti_EndsAfterLastFrame(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.orders = demo.GetDemoOrders()
+	m.view = ViewDetail
+	m.animationFrame = 1
+
+	if !strings.Contains(m.View(), "Delivery day!") {
+		t.Error("detail view should show the confetti banner")
+	}
+
+	for i := 1; i < confettiFrames; i++ {
+		updated, cmd := m.Update(ConfettiTickMsg(time.Now()))
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("animation stopped early at frame %d", m.animationFrame)
+		}
+	}
+	updated, cmd := m.Update(ConfettiTickMsg(time.Now()))
+	m = updated.(Model)
+	if m.animationFrame != 0 || cmd != nil {
+		t.Errorf("animationFrame = %d, cmd = %v; want animation ended", m.animationFrame, cmd)
+	}
+	if strings.Contains(m.View(), "Delivery day!") {
+		t.Error("detail view should be back to normal after the animation")
+	}
+}
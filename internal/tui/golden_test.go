@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+)
+
+// goldenWidth is the terminal width used when rendering golden views
+const goldenWidth = 120
+
+// goldenNow is the fixed clock used when rendering golden views
+var goldenNow = time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+// newGoldenModel returns a demo-mode Model with a fixed clock and width
+func newGoldenModel(t *testing.T) Model {
+	t.Helper()
+
+	// Render without ANSI colors so output does not depend on the terminal
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.Ascii)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	realNow := time.Now()
+	timeNow = func() time.Time { return goldenNow }
+	t.Cleanup(func() { timeNow = time.Now })
+
+	m := newTestModel(t, api.NewMockClient(nil)).WithDemoMode()
+	m.width = goldenWidth
+	m.orders = demo.GetDemoOrders()
+	m.diffs = demo.GetDemoDiffs()
+	m.demoHistory = demo.GetDemoHistory()
+
+	// Demo history is relative to the real clock; rebase it onto goldenNow
+	for _, h := range m.demoHistory {
+		for i := range h.Snapshots {
+			offset := h.Snapshots[i].Timestamp.Sub(realNow).Round(time.Hour)
+			h.Snapshots[i].Timestamp = goldenNow.Add(offset)
+		}
+	}
+	m.timelines = m.buildTimelines(m.orders)
+
+	return m
+}
+
+// assertGolden compares got with testdata/golden/<name>.golden.
+// Run with UPDATE_GOLDEN=1 to regenerate the files.
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "golden", name+".golden")
+
+	if os.Getenv("UPDATE_GOLDEN") == "1" {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("Failed to write golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read golden file %s (run with UPDATE_GOLDEN=1 to create it): %v", path, err)
+	}
+
+	// Tolerate CRLF checkouts on Windows
+	wantStr := strings.ReplaceAll(string(want), "\r\n", "\n")
+	if got != wantStr {
+		t.Errorf("%s does not match golden file %s (run with UPDATE_GOLDEN=1 to update)\n--- got ---\n%s\n--- want ---\n%s",
+			name, path, got, wantStr)
+	}
+}
+
+func TestGolden_DetailsTab(t *testing.T) {
+	m := newGoldenModel(t)
+	order := m.orders[0]
+	assertGolden(t, "details_tab", m.renderDetailsTab(order, m.diffs[order.Order.ReferenceNumber]))
+}
+
+func TestGolden_TasksTab(t *testing.T) {
+	m := newGoldenModel(t)
+	assertGolden(t, "tasks_tab", m.renderTasksTab(m.orders[0]))
+}
+
+func TestGolden_HistoryTab(t *testing.T) {
+	m := newGoldenModel(t)
+	assertGolden(t, "history_tab", m.renderHistoryTab(m.orders[0]))
+}
@@ -8,19 +8,23 @@ import (
 
 // KeyMap contains all key bindings
 type KeyMap struct {
-	Up       key.Binding
-	Down     key.Binding
-	Left     key.Binding
-	Right    key.Binding
-	Enter    key.Binding
-	Back     key.Binding
-	Tab      key.Binding
-	ShiftTab key.Binding
-	Refresh  key.Binding
-	Logout   key.Binding
-	Help     key.Binding
-	Quit     key.Binding
-	Copy     key.Binding
+	Up           key.Binding
+	Down         key.Binding
+	Left         key.Binding
+	Right        key.Binding
+	Enter        key.Binding
+	Back         key.Binding
+	Tab          key.Binding
+	ShiftTab     key.Binding
+	JumpTab      key.Binding
+	Refresh      key.Binding
+	ForceRefresh key.Binding
+	Logout       key.Binding
+	Help         key.Binding
+	Settings     key.Binding
+	Stats        key.Binding
+	Quit         key.Binding
+	Copy         key.Binding
 }
 
 // DefaultKeyMap returns the default key bindings
@@ -57,10 +61,18 @@ var DefaultKeyMap = KeyMap{
 		key.WithKeys("shift+tab"),
 		key.WithHelp("shift+tab", "prev tab"),
 	),
+	JumpTab: key.NewBinding(
+		key.WithKeys("1", "2", "3", "4", "5"),
+		key.WithHelp("1-5", "jump to tab"),
+	),
 	Refresh: key.NewBinding(
 		key.WithKeys("r"),
 		key.WithHelp("r", "refresh"),
 	),
+	ForceRefresh: key.NewBinding(
+		key.WithKeys("ctrl+r"),
+		key.WithHelp("ctrl+r", "force refresh"),
+	),
 	Logout: key.NewBinding(
 		key.WithKeys("L"),
 		key.WithHelp("L", "logout"),
@@ -69,6 +81,14 @@ var DefaultKeyMap = KeyMap{
 		key.WithKeys("?"),
 		key.WithHelp("?", "help"),
 	),
+	Settings: key.NewBinding(
+		key.WithKeys("S"),
+		key.WithHelp("S", "settings"),
+	),
+	Stats: key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "stats"),
+	),
 	Quit: key.NewBinding(
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q", "quit"),
@@ -88,9 +108,17 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Left, k.Right},
-		{k.Enter, k.Back, k.Tab, k.ShiftTab},
-		{k.Refresh, k.Copy, k.Logout, k.Quit},
+		{k.Enter, k.Back, k.Tab, k.ShiftTab, k.JumpTab},
+		{k.Refresh, k.ForceRefresh, k.Copy, k.Logout, k.Settings, k.Stats, k.Quit},
+	}
+}
+
+// tabForKey returns the tab selected by a number key ('1' for the first tab)
+func tabForKey(k string) (Tab, bool) {
+	if len(k) != 1 || k[0] < '1' || k[0] > '5' {
+		return 0, false
 	}
+	return Tab(k[0] - '1'), true
 }
 
 // LoginKeys returns the help text for login view
@@ -98,16 +126,70 @@ func LoginKeys() string {
 	return "enter: login • q: quit"
 }
 
-// OrdersKeys returns the help text for orders view
-func OrdersKeys() string {
-	return "↑/↓: navigate • enter: details • y: copy VIN • r: refresh • L: logout • ?: help • q: quit"
+// OrdersKeys returns the footer help text for orders view, naming the
+// current sort mode. It fits 80 columns; the other actions are listed in
+// OrdersHelp.
+func OrdersKeys(sortMode int) string {
+	return "enter: details • s: sort (" + sortModeNames[sortMode] + ") • r: refresh • ?: help • q: quit"
 }
 
-// DetailKeys returns the help text for detail view, with copy target based on active tab
+// DetailKeys returns the footer help text for detail view, with copy target
+// based on active tab. It fits 80 columns; the tab actions are listed in
+// DetailHelp.
 func DetailKeys(tab Tab) string {
 	copyTarget := "VIN"
 	if tab == TabJSON {
 		copyTarget = "JSON"
 	}
-	return fmt.Sprintf("tab: tabs • ↑/↓: scroll • y: copy %s • esc: back • r: refresh • ?: help • q: quit", copyTarget)
+	return fmt.Sprintf("tab: tabs • y: copy %s • esc: back • ?: help • q: quit", copyTarget)
+}
+
+// actionBinding returns a binding that only documents a key on the help
+// screen; the views handle the key themselves
+func actionBinding(keys, desc string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys), key.WithHelp(keys, desc))
+}
+
+// OrdersHelp lists the orders view actions for the help screen, in columns
+var OrdersHelp = [][]key.Binding{
+	{
+		actionBinding("s", "cycle sort"),
+		actionBinding("y", "copy VIN"),
+		actionBinding("N", "copy reference"),
+	},
+	{
+		actionBinding("P", "pin order"),
+		actionBinding("W", "watch order"),
+		actionBinding("A", "archive order"),
+	},
+	{
+		actionBinding("E", "export orders"),
+		actionBinding("Z", "export checklists"),
+		actionBinding("C", "change log"),
+	},
+}
+
+// DetailHelp lists the detail view actions for the help screen, in columns
+var DetailHelp = [][]key.Binding{
+	{
+		actionBinding("a", "ack changes"),
+		actionBinding("u", "units"),
+		actionBinding("m", "maps"),
+		actionBinding("D", "Google Maps"),
+		actionBinding("O", "schedule delivery"),
+	},
+	{
+		actionBinding("I", "copy ICS"),
+		actionBinding("F", "review changes"),
+		actionBinding("o", "open task link"),
+		actionBinding("T", "task details"),
+		actionBinding("/", "search JSON"),
+	},
+	{
+		actionBinding("n/N", "next/prev JSON match"),
+		actionBinding("v", "raw/combined JSON"),
+		actionBinding("n/p", "next/prev change"),
+		actionBinding("C", "export history CSV"),
+		actionBinding("d", "delete history"),
+	},
 }
@@ -3,6 +3,11 @@ package tui
 import (
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
 )
 
 func TestDefaultKeyMap(t *testing.T) {
@@ -21,9 +26,12 @@ func TestDefaultKeyMap(t *testing.T) {
 		{"Back", km.Back},
 		{"Tab", km.Tab},
 		{"ShiftTab", km.ShiftTab},
+		{"JumpTab", km.JumpTab},
 		{"Refresh", km.Refresh},
 		{"Logout", km.Logout},
 		{"Help", km.Help},
+		{"Settings", km.Settings},
+		{"Stats", km.Stats},
 		{"Quit", km.Quit},
 	}
 
@@ -56,6 +64,8 @@ func TestDefaultKeyMap_SpecificKeys(t *testing.T) {
 		{"Refresh", km.Refresh, []string{"r"}},
 		{"Logout", km.Logout, []string{"L"}},
 		{"Help", km.Help, []string{"?"}},
+		{"Settings", km.Settings, []string{"S"}},
+		{"Stats", km.Stats, []string{"i"}},
 		{"Quit", km.Quit, []string{"q", "ctrl+c"}},
 	}
 
@@ -130,19 +140,26 @@ func TestLoginKeys(t *testing.T) {
 }
 
 func TestOrdersKeys(t *testing.T) {
-	keys := OrdersKeys()
+	keys := OrdersKeys(sortDefault)
 
 	if keys == "" {
 		t.Error("OrdersKeys() returned empty string")
 	}
 
 	// Should contain relevant keys
-	expectedParts := []string{"navigate", "enter", "refresh", "logout", "quit"}
+	expectedParts := []string{"enter", "sort (default)", "refresh", "help", "quit"}
 	for _, part := range expectedParts {
 		if !strings.Contains(strings.ToLower(keys), part) {
 			t.Errorf("OrdersKeys() missing %q", part)
 		}
 	}
+
+	// The footer fits a single 80 column line for every sort mode
+	for mode := range sortModeNames {
+		if w := lipgloss.Width(OrdersKeys(mode)); w > 80 {
+			t.Errorf("OrdersKeys(%s) is %d columns wide, want at most 80", sortModeNames[mode], w)
+		}
+	}
 }
 
 func TestDetailKeys(t *testing.T) {
@@ -153,7 +170,7 @@ func TestDetailKeys(t *testing.T) {
 	}
 
 	// Should contain relevant keys
-	expectedParts := []string{"tab", "scroll", "back", "refresh", "quit", "copy vin"}
+	expectedParts := []string{"tab", "back", "help", "quit", "copy vin"}
 	for _, part := range expectedParts {
 		if !strings.Contains(strings.ToLower(keys), part) {
 			t.Errorf("DetailKeys(TabDetails) missing %q", part)
@@ -165,6 +182,27 @@ func TestDetailKeys(t *testing.T) {
 	if !strings.Contains(strings.ToLower(jsonKeys), "copy json") {
 		t.Error("DetailKeys(TabJSON) should contain 'copy JSON'")
 	}
+
+	// The footer fits a single 80 column line, even with the widest scroll
+	// indicators appended
+	for _, tab := range []Tab{TabDetails, TabTasks, TabJSON, TabHistory} {
+		if w := lipgloss.Width(DetailKeys(tab) + " (100%) ↔ 100%"); w > 80 {
+			t.Errorf("DetailKeys(%d) is %d columns wide, want at most 80", tab, w)
+		}
+	}
+}
+
+func TestViewHelp_ListsFooterActions(t *testing.T) {
+	m := newTestModel(t, api.NewMockClient(nil))
+	m.width, m.height = 120, 40
+	m.help.Width = m.width - 4
+
+	help := m.viewHelp()
+	for _, part := range []string{"copy reference", "pin order", "watch order", "archive order", "units", "delete history", "next/prev JSON match"} {
+		if !strings.Contains(help, part) {
+			t.Errorf("help screen missing %q", part)
+		}
+	}
 }
 
 func TestVimKeybindings(t *testing.T) {
@@ -220,3 +258,54 @@ func TestArrowKeybindings(t *testing.T) {
 		})
 	}
 }
+
+func TestTabForKey(t *testing.T) {
+	tests := []struct {
+		key    string
+		want   Tab
+		wantOK bool
+	}{
+		{"1", TabDetails, true},
+		{"2", TabTasks, true},
+		{"3", TabChecklist, true},
+		{"4", TabHistory, true},
+		{"5", TabJSON, true},
+		{"0", 0, false},
+		{"6", 0, false},
+		{"12", 0, false},
+		{"a", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := tabForKey(tt.key)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("tabForKey(%q) = %v, %v; want %v, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestJumpTab_NoConflicts(t *testing.T) {
+	jumpKeys := DefaultKeyMap.JumpTab.Keys()
+	if len(jumpKeys) != 5 {
+		t.Fatalf("JumpTab has %d keys, want 5", len(jumpKeys))
+	}
+
+	// Viewport scrolling keys must not overlap with tab shortcuts
+	vpKeys := viewport.DefaultKeyMap()
+	for _, b := range []key.Binding{vpKeys.PageDown, vpKeys.PageUp, vpKeys.HalfPageUp, vpKeys.HalfPageDown, vpKeys.Up, vpKeys.Down, vpKeys.Left, vpKeys.Right} {
+		for _, k := range b.Keys() {
+			if _, ok := tabForKey(k); ok {
+				t.Errorf("viewport key %q conflicts with tab shortcut", k)
+			}
+		}
+	}
+
+	// Checklist navigation and toggles must not overlap either
+	for _, k := range []string{"up", "k", "down", "j", "enter", " ", "e", "R"} {
+		if _, ok := tabForKey(k); ok {
+			t.Errorf("checklist key %q conflicts with tab shortcut", k)
+		}
+	}
+}
@@ -1,6 +1,13 @@
 package tui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/model"
+)
 
 // Colors
 var (
@@ -62,6 +69,21 @@ var (
 			Foreground(TeslaWhite).
 			Background(StatusRed)
 
+	// B2BBadgeStyle marks orders placed by a company
+	B2BBadgeStyle = StatusBadgeBase.
+			Foreground(TeslaWhite).
+			Background(StatusBlue)
+
+	// VehicleArtStyle renders the vehicle silhouette in the Details tab
+	VehicleArtStyle = lipgloss.NewStyle().
+			Foreground(Muted).
+			Width(model.ASCIIArtMaxWidth)
+
+	// PreOwnedBadgeStyle marks used inventory vehicles
+	PreOwnedBadgeStyle = StatusBadgeBase.
+				Foreground(TeslaWhite).
+				Background(TeslaGray)
+
 	// Table
 	TableHeaderStyle = lipgloss.NewStyle().
 				Bold(true).
@@ -125,6 +147,11 @@ var (
 			Foreground(StatusRed).
 			Bold(true)
 
+	// Warning
+	WarningStyle = lipgloss.NewStyle().
+			Foreground(StatusYellow).
+			Bold(true)
+
 	// Success
 	SuccessStyle = lipgloss.NewStyle().
 			Foreground(StatusGreen).
@@ -161,6 +188,11 @@ var (
 	JSONBoolStyle = lipgloss.NewStyle().
 			Foreground(TeslaRed)
 
+	// SearchMatchStyle marks search matches in the JSON tab
+	SearchMatchStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#000000")).
+				Background(Highlight)
+
 	// Diff
 	DiffAddedStyle = lipgloss.NewStyle().
 			Foreground(StatusGreen).
@@ -170,6 +202,25 @@ var (
 			Foreground(StatusRed).
 			Strikethrough(true)
 
+	// HistoryFocusStyle outlines the focused snapshot in the History tab
+	HistoryFocusStyle = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(Highlight)
+
+	// ChangeTooltipStyle frames the changed fields shown when hovering over
+	// the orders table's change indicator
+	ChangeTooltipStyle = lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(Highlight).
+				Foreground(TeslaWhite).
+				Padding(0, 1)
+
+	// HighlightStyle draws attention to actions, like the self-scheduling
+	// link in the Details tab
+	HighlightStyle = lipgloss.NewStyle().
+			Foreground(Highlight).
+			Bold(true)
+
 	// Toast notifications
 	ToastStyle = lipgloss.NewStyle().
 			Foreground(TeslaWhite).
@@ -210,6 +261,15 @@ var (
 			Foreground(Muted)
 )
 
+// DisableColor turns off colors and text attributes in every style, for
+// --no-color. It sets NO_COLOR for anything that detects the color profile
+// itself, and skips the terminal background query.
+func DisableColor() {
+	os.Setenv("NO_COLOR", "1")
+	lipgloss.SetColorProfile(termenv.Ascii)
+	lipgloss.SetHasDarkBackground(false)
+}
+
 // GetStatusBadgeStyle returns the appropriate style for an order status
 func GetStatusBadgeStyle(status string) lipgloss.Style {
 	switch {
@@ -0,0 +1,126 @@
+// Package update checks GitHub for newer releases of tesla-delivery-tui
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timeout bounds the whole release lookup
+const Timeout = 5 * time.Second
+
+// latestReleaseURL is the GitHub API endpoint for the latest release;
+// tests point it at a local server
+var latestReleaseURL = "https://api.github.com/repos/marcelblijleven/tesla-delivery-tui/releases/latest"
+
+// Result is the outcome of a version check
+type Result struct {
+	Current         string
+	Latest          string
+	UpdateAvailable bool
+}
+
+// String returns the one-line summary printed by --version-check
+func (r Result) String() string {
+	if r.UpdateAvailable {
+		return "Update available: " + r.Latest
+	}
+	return "Up to date"
+}
+
+// Check fetches the latest release tag from GitHub and compares it to the
+// current version. Versions that are not semantic versions, such as "dev",
+// are treated as older than any release.
+func Check(ctx context.Context, current string) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, latestReleaseURL, nil)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create release request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to fetch latest release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("failed to fetch latest release: status %d", resp.StatusCode)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return Result{}, fmt.Errorf("failed to decode latest release: %w", err)
+	}
+	if release.TagName == "" {
+		return Result{}, fmt.Errorf("latest release has no tag")
+	}
+
+	return Result{
+		Current:         current,
+		Latest:          release.TagName,
+		UpdateAvailable: CompareVersions(current, release.TagName) < 0,
+	}, nil
+}
+
+// CompareVersions compares two versions like "v1.2.3" or "1.2.3-rc1",
+// returning -1, 0 or 1. Pre-release suffixes are ignored. A version that
+// cannot be parsed sorts before one that can.
+func CompareVersions(a, b string) int {
+	pa, okA := parseVersion(a)
+	pb, okB := parseVersion(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return -1
+	case !okB:
+		return 1
+	}
+
+	for i := range pa {
+		if pa[i] != pb[i] {
+			if pa[i] < pb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion parses the major, minor and patch numbers of a version;
+// missing minor or patch numbers count as zero
+func parseVersion(v string) ([3]int, bool) {
+	var parts [3]int
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	if v == "" {
+		return parts, false
+	}
+
+	fields := strings.Split(v, ".")
+	if len(fields) > 3 {
+		return parts, false
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
@@ -0,0 +1,117 @@
+package update
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// withReleaseServer points the release lookup at a test server
+func withReleaseServer(t *testing.T, handler http.HandlerFunc) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	original := latestReleaseURL
+	latestReleaseURL = server.URL
+	t.Cleanup(func() {
+		latestReleaseURL = original
+		server.Close()
+	})
+}
+
+func TestCheck(t *testing.T) {
+	withReleaseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept"); got != "application/vnd.github+json" {
+			t.Errorf("Accept = %q, want application/vnd.github+json", got)
+		}
+		w.Write([]byte(`{"tag_name": "v1.2.3", "name": "v1.2.3"}`))
+	})
+
+	tests := []struct {
+		current string
+		want    string
+	}{
+		{"v1.2.3", "Up to date"},
+		{"1.2.3", "Up to date"},
+		{"v1.3.0", "Up to date"},
+		{"v1.2.2", "Update available: v1.2.3"},
+		{"v1.2.3-rc1", "Up to date"},
+		{"dev", "Update available: v1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current, func(t *testing.T) {
+			result, err := Check(context.Background(), tt.current)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if got := result.String(); got != tt.want {
+				t.Errorf("Check(%q) = %q, want %q", tt.current, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"not found", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNotFound) }},
+		{"invalid JSON", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(`{`)) }},
+		{"missing tag", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte(`{}`)) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withReleaseServer(t, tt.handler)
+			if _, err := Check(context.Background(), "v1.0.0"); err == nil {
+				t.Error("Check() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestCheck_Timeout(t *testing.T) {
+	done := make(chan struct{})
+	withReleaseServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	})
+	defer close(done)
+
+	// A cancelled parent context stops the request like the 5 second timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := Check(ctx, "v1.0.0"); err == nil {
+		t.Error("Check() error = nil, want timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > Timeout {
+		t.Errorf("Check() took %v, want it to stop at the deadline", elapsed)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.2.3", "v1.2.3", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"v1.2", "v1.2.0", 0},
+		{"v1.2.3", "v1.10.0", -1},
+		{"v2.0.0", "v1.99.99", 1},
+		{"v1.2.3-beta", "v1.2.3", 0},
+		{"dev", "v0.0.1", -1},
+		{"v0.0.1", "dev", 1},
+		{"dev", "unknown", 0},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -1,19 +1,29 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"log/slog"
 	"os"
-	"time"
+	"path/filepath"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/api"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/config"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/demo"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/export"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/storage"
 	"github.com/marcelblijleven/tesla-delivery-tui/internal/tui"
+	"github.com/marcelblijleven/tesla-delivery-tui/internal/update"
 )
 
+// debugLogFile is where --debug logs without --log-file, in the config
+// directory
+const debugLogFile = "debug.log"
+
 // Version information (set by goreleaser via ldflags)
 var (
 	version = "dev"
@@ -23,28 +33,94 @@ var (
 
 func main() {
 	// Parse flags
-	demoMode := flag.Bool("demo", false, "Run in demo mode with mock data")
-	showVersion := flag.Bool("version", false, "Show version information")
-	watchMode := flag.Bool("watch", false, "Auto-refresh every 5 minutes")
-	watchInterval := flag.Duration("interval", 5*time.Minute, "Auto-refresh interval (e.g., 10m, 1h)")
+	opts := registerFlags(flag.CommandLine)
 	flag.Parse()
 
-	if *showVersion {
+	if opts.completion != "" {
+		if err := writeCompletion(os.Stdout, opts.completion, flag.CommandLine); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if opts.showVersion {
 		fmt.Printf("tesla-delivery-tui %s\n", version)
 		fmt.Printf("  commit: %s\n", commit)
 		fmt.Printf("  built:  %s\n", date)
 		os.Exit(0)
 	}
 
+	if opts.versionCheck {
+		result, err := update.Check(context.Background(), version)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(result)
+		os.Exit(0)
+	}
+
+	if opts.demoScenario != "" {
+		if _, _, _, err := demo.GetDemoScenario(opts.demoScenario); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if opts.region != "" && !config.ValidRegion(opts.region) {
+		fmt.Fprintf(os.Stderr, "Error: unknown region %q (available: %s)\n", opts.region, strings.Join(config.Regions, ", "))
+		os.Exit(1)
+	}
+
+	export.Version = version
+
 	// Initialize config
-	cfg, err := config.New()
+	cfg, err := config.New(opts.configDir)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error initializing config: %v\n", err)
 		os.Exit(1)
 	}
+	if opts.noKeyring {
+		if err := cfg.DisableKeyring(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error moving tokens out of the keyring: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Initialize structured logging (disabled unless --log-file or --debug is
+	// set). Debug logs never go to stderr, which the TUI draws over.
+	if opts.debug && opts.logFile == "" {
+		opts.logFile = filepath.Join(cfg.ConfigDir(), debugLogFile)
+	}
+	logger := slog.New(slog.DiscardHandler)
+	if opts.logFile != "" {
+		f, err := os.OpenFile(opts.logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening log file: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		level := slog.LevelInfo
+		if opts.debug {
+			level = slog.LevelDebug
+		}
+		logger = slog.New(slog.NewJSONHandler(f, &slog.HandlerOptions{Level: level}))
+	}
 
 	// Initialize API client
 	client := api.NewClient(cfg)
+	client.SetLogger(logger)
+	client.SetRateLimit(opts.rateLimit)
+	client.SetFleetAPI(opts.fleetAPI)
+	client.SetRegion(cfg.Settings().Region)
+
+	if opts.healthCheck {
+		if opts.region != "" {
+			client.SetRegion(opts.region)
+		}
+		os.Exit(runHealthCheck(os.Stdout, cfg, client))
+	}
 
 	// Initialize history storage
 	history, err := storage.NewHistory(cfg.ConfigDir())
@@ -52,6 +128,10 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Error initializing history storage: %v\n", err)
 		os.Exit(1)
 	}
+	history.SetMaxEntries(cfg.Settings().MaxHistoryEntries)
+	if err := history.PruneOldEntries(storage.DefaultMaxHistoryAge); err != nil {
+		logger.Warn("failed to prune history", "error", err.Error())
+	}
 
 	// Initialize checklist storage
 	checklist, err := storage.NewChecklist(cfg.ConfigDir())
@@ -60,22 +140,99 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Initialize acknowledgement storage for change notifications
+	acks, err := storage.NewAcknowledgements(cfg.ConfigDir())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing acknowledgement storage: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize archive of hidden orders
+	archive, err := storage.NewArchive(cfg.ConfigDir())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing archive storage: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize pinned orders
+	pins, err := storage.NewPins(cfg.ConfigDir())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing pin storage: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Styles must see --no-color before the model creates its progress bars
+	if opts.noColor {
+		tui.DisableColor()
+	}
+
 	// Create the TUI model
-	model := tui.New(cfg, client, history, checklist)
-	if *demoMode {
+	model := tui.New(cfg, client, history, checklist).
+		WithLogger(logger).
+		WithAcknowledgements(acks).
+		WithArchive(archive, opts.showArchived).
+		WithPins(pins)
+	if opts.demoScenario != "" {
+		model = model.WithDemoScenario(opts.demoScenario)
+	} else if opts.demoMode {
 		model = model.WithDemoMode()
 	}
-	if *watchMode {
-		model = model.WithAutoRefresh(*watchInterval)
+	if opts.region != "" {
+		model = model.WithRegion(opts.region)
+	}
+	if opts.noArt {
+		model = model.WithoutArt()
+	}
+	if opts.minimal {
+		model = model.WithMinimal()
+	}
+	if opts.noColor {
+		model = model.WithNoColor()
+	}
+	if opts.minWidth > 0 || opts.minHeight > 0 {
+		model = model.WithMinTerminalSize(opts.minWidth, opts.minHeight)
+	}
+	if opts.sound {
+		model = model.WithSoundAlert()
+	}
+	if opts.debug {
+		model = model.WithDebug(logger)
+	}
+	if !opts.noVersionCheck && version != "dev" {
+		model = model.WithUpdateCheck(version)
+	}
+	if isFlagSet("toast-duration") {
+		model = model.WithToastDuration(opts.toastDuration)
+	}
+	if opts.watchMode {
+		// An explicit --interval wins over the interval saved in settings
+		interval := opts.watchInterval
+		if !isFlagSet("interval") {
+			if saved := cfg.Settings().AutoRefreshInterval; saved > 0 {
+				interval = saved
+			}
+		}
+		model = model.WithAutoRefresh(interval)
 	}
 
 	// Run the program with mouse support
 	p := tea.NewProgram(model,
 		tea.WithAltScreen(),
-		tea.WithMouseCellMotion(),
+		tea.WithMouseAllMotion(),
 	)
 	if _, err := p.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// isFlagSet reports whether the named flag was passed on the command line
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}